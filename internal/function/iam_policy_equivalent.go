@@ -0,0 +1,68 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+var _ function.Function = iamPolicyEquivalentFunction{}
+
+func NewIAMPolicyEquivalentFunction() function.Function {
+	return &iamPolicyEquivalentFunction{}
+}
+
+type iamPolicyEquivalentFunction struct{}
+
+func (f iamPolicyEquivalentFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_equivalent"
+}
+
+func (f iamPolicyEquivalentFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "iam_policy_equivalent Function",
+		MarkdownDescription: "Compares two IAM policy document JSON strings and returns true if they are " +
+			"semantically equivalent. Differences in statement ordering, element ordering and " +
+			"list-vs-string representation are ignored.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "policy1",
+				MarkdownDescription: "IAM policy document JSON string",
+			},
+			function.StringParameter{
+				Name:                "policy2",
+				MarkdownDescription: "IAM policy document JSON string",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f iamPolicyEquivalentFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var policy1, policy2 string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &policy1, &policy2))
+	if resp.Error != nil {
+		return
+	}
+
+	// Normalizing first surfaces invalid documents as errors rather than as "not equivalent".
+	normalized1, err := iampolicy.Normalize(policy1)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+	}
+	normalized2, err := iampolicy.Normalize(policy2)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+	}
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, verify.PolicyStringsEquivalent(normalized1, normalized2)))
+}
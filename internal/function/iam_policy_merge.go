@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
+)
+
+var _ function.Function = iamPolicyMergeFunction{}
+
+func NewIAMPolicyMergeFunction() function.Function {
+	return &iamPolicyMergeFunction{}
+}
+
+type iamPolicyMergeFunction struct{}
+
+func (f iamPolicyMergeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_merge"
+}
+
+func (f iamPolicyMergeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "iam_policy_merge Function",
+		MarkdownDescription: "Merges IAM policy document JSON strings into a single policy document. " +
+			"Statements are combined in order, equivalent statements sharing a Sid are deduplicated and " +
+			"conflicting statements sharing a Sid result in an error.",
+		VariadicParameter: function.StringParameter{
+			Name:                "policies",
+			MarkdownDescription: "IAM policy document JSON strings",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f iamPolicyMergeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var policies []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &policies))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := iampolicy.Merge(policies...)
+	if err != nil {
+		var position int64
+		if docErr, ok := errors.AsType[*iampolicy.DocumentError](err); ok {
+			position = int64(docErr.Index)
+		}
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(position, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
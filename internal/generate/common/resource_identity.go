@@ -135,6 +135,14 @@ func (a IdentityAttribute) ResourceAttributeName() string {
 	return namesgen.ConstOrQuote(a.ResourceAttributeName_)
 }
 
+// StateAttributeName returns the name of the resource attribute holding the identity attribute's value.
+func (a IdentityAttribute) StateAttributeName() string {
+	if a.ResourceAttributeName_ != "" {
+		return a.ResourceAttributeName()
+	}
+	return a.Name()
+}
+
 func ParseResourceIdentity(annotationName string, args Args, implementation Implementation, d *ResourceIdentity, goImports *[]GoImport) (errs error) {
 	switch annotationName {
 	case "ArnIdentity":
@@ -583,6 +583,34 @@ if err != nil {
 		}
 	}
 
+	if attr, ok := args.Keyword["randomSSHPublicKey"]; ok {
+		if b, err := common.ParseBoolAttr("randomSSHPublicKey", attr); err != nil {
+			return err
+		} else if b {
+			varName := "rSSHPublicKey"
+			stuff.GoImports = append(stuff.GoImports,
+				common.GoImport{
+					Path:  "github.com/hashicorp/terraform-plugin-testing/helper/acctest",
+					Alias: "sdkacctest",
+				},
+				common.GoImport{
+					Path: "github.com/hashicorp/terraform-provider-aws/internal/acctest",
+				},
+			)
+			stuff.InitCodeBlocks = append(stuff.InitCodeBlocks, CodeBlock{
+				Code: fmt.Sprintf(`%s, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+if err != nil {
+	t.Fatal(err)
+}
+`, varName),
+			})
+			stuff.AdditionalTfVars_[varName] = TFVar{
+				GoVarName: varName,
+				Type:      TFVarTypeString,
+			}
+		}
+	}
+
 	// Resource Identity Versions
 	if attr, ok := args.Keyword["preIdentityVersion"]; ok {
 		version, err := version.NewVersion(attr)
@@ -0,0 +1,444 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package iampolicy models IAM policy documents.
+package iampolicy
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/jmespath/go-jmespath"
+)
+
+const (
+	marshallJSONStartSliceSize = 2
+)
+
+type Document struct {
+	Version    string       `json:",omitempty"`
+	Id         string       `json:",omitempty"`
+	Statements []*Statement `json:"Statement,omitempty"`
+}
+
+type Statement struct {
+	Sid           string                `json:",omitempty"`
+	Effect        string                `json:",omitempty"`
+	Actions       any                   `json:"Action,omitempty"`
+	NotActions    any                   `json:"NotAction,omitempty"`
+	Resources     any                   `json:"Resource,omitempty"`
+	NotResources  any                   `json:"NotResource,omitempty"`
+	Principals    StatementPrincipalSet `json:"Principal,omitempty"`
+	NotPrincipals StatementPrincipalSet `json:"NotPrincipal,omitempty"`
+	Conditions    StatementConditionSet `json:"Condition,omitempty"`
+}
+
+type StatementPrincipal struct {
+	Type        string
+	Identifiers any
+}
+
+type StatementPrincipalSet []StatementPrincipal
+
+type StatementCondition struct {
+	Test     string
+	Variable string
+	Values   any
+}
+
+type StatementConditionSet []StatementCondition
+
+func (s *Document) Merge(newDoc *Document) {
+	// adopt newDoc's Id
+	if len(newDoc.Id) > 0 {
+		s.Id = newDoc.Id
+	}
+
+	// let newDoc upgrade our Version
+	if newDoc.Version > s.Version {
+		s.Version = newDoc.Version
+	}
+
+	// merge in newDoc's statements, overwriting any existing Sids
+	var seen bool
+	for _, newStatement := range newDoc.Statements {
+		if len(newStatement.Sid) == 0 {
+			s.Statements = append(s.Statements, newStatement)
+			continue
+		}
+		seen = false
+		for i, existingStatement := range s.Statements {
+			if existingStatement.Sid == newStatement.Sid {
+				s.Statements[i] = newStatement
+				seen = true
+				break
+			}
+		}
+		if !seen {
+			s.Statements = append(s.Statements, newStatement)
+		}
+	}
+}
+
+func (ps StatementPrincipalSet) MarshalJSON() ([]byte, error) {
+	raw := map[string]any{}
+
+	// Although IAM documentation says that "*" and {"AWS": "*"} are equivalent
+	// (https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_elements_principal.html),
+	// in practice they are not for IAM roles. IAM will return an error if trust
+	// policy have "*" or {"*": "*"} as principal, but will accept {"AWS": "*"}.
+	// Only {"*": "*"} should be normalized to "*".
+	if len(ps) == 1 {
+		p := ps[0]
+		if p.Type == "*" {
+			if sv, ok := p.Identifiers.(string); ok && sv == "*" {
+				return []byte(`"*"`), nil
+			}
+
+			if av, ok := p.Identifiers.([]string); ok && len(av) == 1 && av[0] == "*" {
+				return []byte(`"*"`), nil
+			}
+		}
+	}
+
+	for _, p := range ps {
+		switch i := p.Identifiers.(type) {
+		case []string:
+			switch v := raw[p.Type].(type) {
+			case nil:
+				raw[p.Type] = make([]string, 0, len(i))
+			case string:
+				// Convert to []string to prevent panic
+				raw[p.Type] = make([]string, 0, len(i)+1)
+				raw[p.Type] = append(raw[p.Type].([]string), v)
+			}
+			slices.Sort(i)
+			slices.Reverse(i)
+			raw[p.Type] = append(raw[p.Type].([]string), i...)
+		case string:
+			switch v := raw[p.Type].(type) {
+			case nil:
+				raw[p.Type] = i
+			case string:
+				// Convert to []string to stop drop of principals
+				raw[p.Type] = make([]string, 0, marshallJSONStartSliceSize)
+				raw[p.Type] = append(raw[p.Type].([]string), v)
+				raw[p.Type] = append(raw[p.Type].([]string), i)
+			case []string:
+				raw[p.Type] = append(raw[p.Type].([]string), i)
+			}
+		default:
+			return []byte{}, fmt.Errorf("Unsupported data type %T for IAMPolicyStatementPrincipalSet", i)
+		}
+	}
+
+	return json.Marshal(&raw)
+}
+
+func (ps *StatementPrincipalSet) UnmarshalJSON(b []byte) error {
+	var out StatementPrincipalSet
+
+	var data any
+	if err := json.Unmarshal(b, &data); err != nil {
+		return err
+	}
+
+	switch t := data.(type) {
+	case string:
+		out = append(out, StatementPrincipal{Type: "*", Identifiers: []string{"*"}})
+	case map[string]any:
+		for key, value := range data.(map[string]any) {
+			switch vt := value.(type) {
+			case string:
+				out = append(out, StatementPrincipal{Type: key, Identifiers: value.(string)})
+			case []any:
+				values := []string{}
+				for i, v := range value.([]any) {
+					s, ok := v.(string)
+					if !ok {
+						return fmt.Errorf("Unsupported element type %T for IAMPolicyStatementPrincipalSet.Identifiers[%d] (principal type %q): must be string", v, i, key)
+					}
+					values = append(values, s)
+				}
+				slices.Sort(values)
+				out = append(out, StatementPrincipal{Type: key, Identifiers: values})
+			default:
+				return fmt.Errorf("Unsupported data type %T for IAMPolicyStatementPrincipalSet.Identifiers", vt)
+			}
+		}
+	default:
+		return fmt.Errorf("Unsupported data type %T for IAMPolicyStatementPrincipalSet", t)
+	}
+
+	*ps = out
+	return nil
+}
+
+func (cs StatementConditionSet) MarshalJSON() ([]byte, error) {
+	raw := map[string]map[string]any{}
+
+	for _, c := range cs {
+		if _, ok := raw[c.Test]; !ok {
+			raw[c.Test] = map[string]any{}
+		}
+		if _, ok := raw[c.Test][c.Variable]; !ok {
+			raw[c.Test][c.Variable] = []string{}
+		}
+		switch i := c.Values.(type) {
+		case []string:
+			// order matters with values so not sorting here
+			raw[c.Test][c.Variable] = append(raw[c.Test][c.Variable].([]string), i...)
+		case string:
+			raw[c.Test][c.Variable] = append(raw[c.Test][c.Variable].([]string), i)
+		default:
+			return nil, fmt.Errorf("Unsupported data type for IAMPolicyStatementConditionSet: %s", i)
+		}
+	}
+
+	// flatten entries with a single item to match AWS IAM syntax
+	for k1 := range raw {
+		for k2 := range raw[k1] {
+			items := raw[k1][k2].([]string)
+			if len(items) == 1 {
+				raw[k1][k2] = items[0]
+			}
+		}
+	}
+
+	return json.Marshal(&raw)
+}
+
+func (cs *StatementConditionSet) UnmarshalJSON(b []byte) error {
+	var out StatementConditionSet
+
+	var data map[string]map[string]any
+	if err := json.Unmarshal(b, &data); err != nil {
+		return err
+	}
+
+	for test_key, test_value := range data {
+		for var_key, var_values := range test_value {
+			switch var_values := var_values.(type) {
+			case string:
+				out = append(out, StatementCondition{Test: test_key, Variable: var_key, Values: []string{var_values}})
+			case bool:
+				out = append(out, StatementCondition{Test: test_key, Variable: var_key, Values: strconv.FormatBool(var_values)})
+			case []any:
+				values := []string{}
+				for _, v := range var_values {
+					values = append(values, v.(string))
+				}
+				out = append(out, StatementCondition{Test: test_key, Variable: var_key, Values: values})
+			}
+		}
+	}
+
+	*cs = out
+	return nil
+}
+
+// DecodeConfigStringList returns a single-element list as a string and sorts
+// longer lists in descending order.
+func DecodeConfigStringList(lI []any) any {
+	if len(lI) == 1 {
+		return lI[0].(string)
+	}
+	ret := make([]string, len(lI))
+	for i, vI := range lI {
+		ret[i] = vI.(string)
+	}
+	slices.Sort(ret)
+	slices.Reverse(ret)
+	return ret
+}
+
+// HasValidAWSPrincipals validates that the Principals in an IAM Policy are valid
+// Assumes that non-"AWS" Principals are valid
+// The value can be a single string or a slice of strings
+// Valid strings are either an ARN or an AWS account ID
+func HasValidAWSPrincipals(policy string) (bool, error) { // nosemgrep:ci.aws-in-func-name
+	var policyData any
+	err := json.Unmarshal([]byte(policy), &policyData)
+	if err != nil {
+		return false, fmt.Errorf("parsing policy: %w", err)
+	}
+
+	result, err := jmespath.Search("Statement[*].Principal.AWS", policyData)
+	if err != nil {
+		return false, fmt.Errorf("parsing policy: %w", err)
+	}
+
+	principals, ok := result.([]any)
+	if !ok {
+		return false, fmt.Errorf(`parsing policy: unexpected result: (%[1]T) "%[1]v"`, result)
+	}
+
+	for _, principal := range principals {
+		switch x := principal.(type) {
+		case string:
+			if !IsValidAWSPrincipal(x) {
+				return false, nil
+			}
+		case []string:
+			for _, s := range x {
+				if !IsValidAWSPrincipal(s) {
+					return false, nil
+				}
+			}
+		}
+	}
+
+	return true, nil
+}
+
+// IsValidAWSPrincipal returns true if a string is a valid AWS Princial for an IAM Policy document
+// That is: either an ARN, an AWS account ID, or `*`
+func IsValidAWSPrincipal(principal string) bool { // nosemgrep:ci.aws-in-func-name
+	if principal == "*" {
+		return true
+	}
+	if arn.IsARN(principal) {
+		return true
+	}
+	if regexache.MustCompile(`^\d{12}$`).MatchString(principal) {
+		return true
+	}
+	return false
+}
+
+// Normalize returns the canonical JSON form of an IAM policy document.
+// Action, NotAction, Resource, NotResource and principal identifier lists are sorted
+// in descending order, matching the output of the aws_iam_policy_document data source,
+// and single-element lists are collapsed to strings so that documents that differ
+// only in element ordering or list-vs-string representation normalize identically.
+func Normalize(policy string) (string, error) {
+	doc, err := documentNormalize(policy)
+	if err != nil {
+		return "", err
+	}
+
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return "", fmt.Errorf("writing IAM policy document: %w", err)
+	}
+
+	return string(b), nil
+}
+
+// DocumentError is returned by Merge when the policy document at Index cannot be merged.
+type DocumentError struct {
+	Index int
+	Err   error
+}
+
+func (e *DocumentError) Error() string {
+	return fmt.Sprintf("merging policy document %d: %s", e.Index, e.Err)
+}
+
+func (e *DocumentError) Unwrap() error {
+	return e.Err
+}
+
+// Merge merges IAM policy documents in order. Statements with the same Sid
+// are deduplicated if they are equivalent; conflicting statements are an error.
+// Errors caused by a specific policy document are of type *DocumentError.
+func Merge(policies ...string) (string, error) {
+	mergedDoc := &Document{}
+	sids := make(map[string][]byte)
+
+	for i, policy := range policies {
+		if strings.TrimSpace(policy) == "" {
+			continue
+		}
+
+		doc, err := documentNormalize(policy)
+		if err != nil {
+			return "", &DocumentError{Index: i, Err: err}
+		}
+
+		statements := make([]*Statement, 0, len(doc.Statements))
+		for j, stmt := range doc.Statements {
+			if stmt.Sid == "" {
+				statements = append(statements, stmt)
+				continue
+			}
+
+			b, err := json.Marshal(stmt)
+			if err != nil {
+				return "", &DocumentError{Index: i, Err: fmt.Errorf("statement %d: %w", j, err)}
+			}
+
+			if existing, ok := sids[stmt.Sid]; ok {
+				if !bytes.Equal(existing, b) {
+					return "", &DocumentError{Index: i, Err: fmt.Errorf("statement %d: conflicting statements with Sid (%s)", j, stmt.Sid)}
+				}
+				continue
+			}
+
+			sids[stmt.Sid] = b
+			statements = append(statements, stmt)
+		}
+		doc.Statements = statements
+
+		mergedDoc.Merge(doc)
+	}
+
+	b, err := json.Marshal(mergedDoc)
+	if err != nil {
+		return "", fmt.Errorf("writing IAM policy document: %w", err)
+	}
+
+	return string(b), nil
+}
+
+func documentNormalize(policy string) (*Document, error) {
+	doc := &Document{}
+	if err := json.Unmarshal([]byte(policy), doc); err != nil {
+		return nil, fmt.Errorf("parsing IAM policy document: %w", err)
+	}
+
+	for i, stmt := range doc.Statements {
+		if stmt == nil {
+			return nil, fmt.Errorf("parsing IAM policy document: statement %d is null", i)
+		}
+
+		for _, v := range []*any{&stmt.Actions, &stmt.NotActions, &stmt.Resources, &stmt.NotResources} {
+			normalized, err := normalizeStringList(*v)
+			if err != nil {
+				return nil, fmt.Errorf("parsing IAM policy document: statement %d: %w", i, err)
+			}
+			*v = normalized
+		}
+
+		for _, ps := range []StatementPrincipalSet{stmt.Principals, stmt.NotPrincipals} {
+			for j, p := range ps {
+				if v, ok := p.Identifiers.([]string); ok && len(v) == 1 {
+					ps[j].Identifiers = v[0]
+				}
+			}
+		}
+	}
+
+	return doc, nil
+}
+
+func normalizeStringList(v any) (any, error) {
+	l, ok := v.([]any)
+	if !ok {
+		return v, nil
+	}
+
+	for i, e := range l {
+		if _, ok := e.(string); !ok {
+			return nil, fmt.Errorf("unsupported element type %T at index %d: must be string", e, i)
+		}
+	}
+
+	return DecodeConfigStringList(l), nil
+}
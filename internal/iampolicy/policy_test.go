@@ -1,21 +1,21 @@
 // Copyright IBM Corp. 2014, 2026
 // SPDX-License-Identifier: MPL-2.0
 
-package iam_test
+package iampolicy_test
 
 import (
 	"encoding/json"
+	"errors"
 	"reflect"
 	"slices"
 	"testing"
 
-	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
-	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-func TestPolicyHasValidAWSPrincipals(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+func TestHasValidAWSPrincipals(t *testing.T) { // nosemgrep:ci.aws-in-func-name
 	t.Parallel()
 
 	testcases := map[string]struct {
@@ -211,7 +211,7 @@ func TestPolicyHasValidAWSPrincipals(t *testing.T) { // nosemgrep:ci.aws-in-func
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			valid, err := tfiam.PolicyHasValidAWSPrincipals(testcase.json)
+			valid, err := iampolicy.HasValidAWSPrincipals(testcase.json)
 
 			if testcase.err == nil {
 				if err != nil {
@@ -247,7 +247,7 @@ func TestIsValidAWSPrincipal(t *testing.T) { // nosemgrep:ci.aws-in-func-name
 			valid: true,
 		},
 		names.AttrAccountID: {
-			value: acctest.Ct12Digit,
+			value: "123456789012",
 			valid: true,
 		},
 		"unique_id": {
@@ -260,7 +260,7 @@ func TestIsValidAWSPrincipal(t *testing.T) { // nosemgrep:ci.aws-in-func-name
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			a := tfiam.IsValidPolicyAWSPrincipal(testcase.value)
+			a := iampolicy.IsValidAWSPrincipal(testcase.value)
 
 			if e := testcase.valid; a != e {
 				t.Fatalf("expected %t, got %t", e, a)
@@ -269,49 +269,49 @@ func TestIsValidAWSPrincipal(t *testing.T) { // nosemgrep:ci.aws-in-func-name
 	}
 }
 
-func TestIAMPolicyStatementConditionSet_MarshalJSON(t *testing.T) { // nosemgrep:ci.iam-in-func-name
+func TestStatementConditionSet_MarshalJSON(t *testing.T) {
 	t.Parallel()
 
 	testcases := map[string]struct {
-		cs      tfiam.IAMPolicyStatementConditionSet
+		cs      iampolicy.StatementConditionSet
 		want    []byte
 		wantErr bool
 	}{
 		"invalid value type": {
-			cs: tfiam.IAMPolicyStatementConditionSet{
+			cs: iampolicy.StatementConditionSet{
 				{Test: "StringLike", Variable: "s3:prefix", Values: 1},
 			},
 			wantErr: true,
 		},
 		"single condition single value": {
-			cs: tfiam.IAMPolicyStatementConditionSet{
+			cs: iampolicy.StatementConditionSet{
 				{Test: "StringLike", Variable: "s3:prefix", Values: "one/"},
 			},
 			want: []byte(`{"StringLike":{"s3:prefix":"one/"}}`),
 		},
 		"single condition multiple values": {
-			cs: tfiam.IAMPolicyStatementConditionSet{
+			cs: iampolicy.StatementConditionSet{
 				{Test: "StringLike", Variable: "s3:prefix", Values: []string{"one/", "two/"}},
 			},
 			want: []byte(`{"StringLike":{"s3:prefix":["one/","two/"]}}`),
 		},
 		// Multiple distinct conditions
 		"multiple condition single value": {
-			cs: tfiam.IAMPolicyStatementConditionSet{
+			cs: iampolicy.StatementConditionSet{
 				{Test: "ArnNotLike", Variable: "aws:PrincipalArn", Values: "1"},
 				{Test: "StringLike", Variable: "s3:prefix", Values: "one/"},
 			},
 			want: []byte(`{"ArnNotLike":{"aws:PrincipalArn":"1"},"StringLike":{"s3:prefix":"one/"}}`),
 		},
 		"multiple condition multiple values": {
-			cs: tfiam.IAMPolicyStatementConditionSet{
+			cs: iampolicy.StatementConditionSet{
 				{Test: "ArnNotLike", Variable: "aws:PrincipalArn", Values: []string{"1", "2"}},
 				{Test: "StringLike", Variable: "s3:prefix", Values: []string{"one/", "two/"}},
 			},
 			want: []byte(`{"ArnNotLike":{"aws:PrincipalArn":["1","2"]},"StringLike":{"s3:prefix":["one/","two/"]}}`),
 		},
 		"multiple condition mixed value lengths": {
-			cs: tfiam.IAMPolicyStatementConditionSet{
+			cs: iampolicy.StatementConditionSet{
 				{Test: "ArnNotLike", Variable: "aws:PrincipalArn", Values: "1"},
 				{Test: "StringLike", Variable: "s3:prefix", Values: []string{"one/", "two/"}},
 			},
@@ -319,28 +319,28 @@ func TestIAMPolicyStatementConditionSet_MarshalJSON(t *testing.T) { // nosemgrep
 		},
 		// Multiple conditions with duplicated `test` arguments
 		"duplicate condition test single value": {
-			cs: tfiam.IAMPolicyStatementConditionSet{
+			cs: iampolicy.StatementConditionSet{
 				{Test: "StringLike", Variable: "s3:prefix", Values: "one/"},
 				{Test: "StringLike", Variable: "s3:versionid", Values: "abc123"},
 			},
 			want: []byte(`{"StringLike":{"s3:prefix":"one/","s3:versionid":"abc123"}}`),
 		},
 		"duplicate condition test multiple values": {
-			cs: tfiam.IAMPolicyStatementConditionSet{
+			cs: iampolicy.StatementConditionSet{
 				{Test: "StringLike", Variable: "s3:prefix", Values: []string{"one/", "two/"}},
 				{Test: "StringLike", Variable: "s3:versionid", Values: []string{"abc123", "def456"}},
 			},
 			want: []byte(`{"StringLike":{"s3:prefix":["one/","two/"],"s3:versionid":["abc123","def456"]}}`),
 		},
 		"duplicate condition test mixed value lengths": {
-			cs: tfiam.IAMPolicyStatementConditionSet{
+			cs: iampolicy.StatementConditionSet{
 				{Test: "StringLike", Variable: "s3:prefix", Values: "one/"},
 				{Test: "StringLike", Variable: "s3:versionid", Values: []string{"abc123", "def456"}},
 			},
 			want: []byte(`{"StringLike":{"s3:prefix":"one/","s3:versionid":["abc123","def456"]}}`),
 		},
 		"duplicate condition test mixed value lengths reversed": {
-			cs: tfiam.IAMPolicyStatementConditionSet{
+			cs: iampolicy.StatementConditionSet{
 				{Test: "StringLike", Variable: "s3:prefix", Values: []string{"one/", "two/"}},
 				{Test: "StringLike", Variable: "s3:versionid", Values: "abc123"},
 			},
@@ -348,28 +348,28 @@ func TestIAMPolicyStatementConditionSet_MarshalJSON(t *testing.T) { // nosemgrep
 		},
 		// Multiple conditions with duplicated `test` and `variable` arguments
 		"duplicate condition test and variable single value": {
-			cs: tfiam.IAMPolicyStatementConditionSet{
+			cs: iampolicy.StatementConditionSet{
 				{Test: "StringLike", Variable: "s3:prefix", Values: "one/"},
 				{Test: "StringLike", Variable: "s3:prefix", Values: "two/"},
 			},
 			want: []byte(`{"StringLike":{"s3:prefix":["one/","two/"]}}`),
 		},
 		"duplicate condition test and variable multiple values": {
-			cs: tfiam.IAMPolicyStatementConditionSet{
+			cs: iampolicy.StatementConditionSet{
 				{Test: "StringLike", Variable: "s3:prefix", Values: []string{"one/", "two/"}},
 				{Test: "StringLike", Variable: "s3:prefix", Values: []string{"three/", "four/"}},
 			},
 			want: []byte(`{"StringLike":{"s3:prefix":["one/","two/","three/","four/"]}}`),
 		},
 		"duplicate condition test and variable mixed value lengths": {
-			cs: tfiam.IAMPolicyStatementConditionSet{
+			cs: iampolicy.StatementConditionSet{
 				{Test: "StringLike", Variable: "s3:prefix", Values: "one/"},
 				{Test: "StringLike", Variable: "s3:prefix", Values: []string{"three/", "four/"}},
 			},
 			want: []byte(`{"StringLike":{"s3:prefix":["one/","three/","four/"]}}`),
 		},
 		"duplicate condition test and variable mixed value lengths reversed": {
-			cs: tfiam.IAMPolicyStatementConditionSet{
+			cs: iampolicy.StatementConditionSet{
 				{Test: "StringLike", Variable: "s3:prefix", Values: []string{"one/", "two/"}},
 				{Test: "StringLike", Variable: "s3:prefix", Values: "three/"},
 			},
@@ -392,7 +392,7 @@ func TestIAMPolicyStatementConditionSet_MarshalJSON(t *testing.T) { // nosemgrep
 	}
 }
 
-func TestPolicyUnmarshalServicePrincipalOrder(t *testing.T) {
+func TestUnmarshalServicePrincipalOrder(t *testing.T) {
 	t.Parallel()
 
 	policy1 := `
@@ -415,8 +415,8 @@ func TestPolicyUnmarshalServicePrincipalOrder(t *testing.T) {
 			"Sid": ""
 		  }`
 
-	var data1 tfiam.IAMPolicyStatement
-	var data2 tfiam.IAMPolicyStatement
+	var data1 iampolicy.Statement
+	var data2 iampolicy.Statement
 	err := json.Unmarshal([]byte(policy1), &data1)
 	if err != nil {
 		t.Fatal(err)
@@ -430,34 +430,34 @@ func TestPolicyUnmarshalServicePrincipalOrder(t *testing.T) {
 	}
 }
 
-func TestIAMPolicyStatementPrincipalSet_UnmarshalJSON(t *testing.T) { // nosemgrep:ci.iam-in-func-name
+func TestStatementPrincipalSet_UnmarshalJSON(t *testing.T) {
 	t.Parallel()
 
 	testcases := map[string]struct {
 		b       []byte
-		want    tfiam.IAMPolicyStatementPrincipalSet
+		want    iampolicy.StatementPrincipalSet
 		wantErr bool
 	}{
 		"wildcard, wildcard": {
 			b: []byte(`{"*": "*"}`),
-			want: tfiam.IAMPolicyStatementPrincipalSet{
+			want: iampolicy.StatementPrincipalSet{
 				{Type: "*", Identifiers: "*"},
 			},
 		},
 		"single key, wildcard": {b: []byte(`{"AWS": "*"}`),
-			want: tfiam.IAMPolicyStatementPrincipalSet{
+			want: iampolicy.StatementPrincipalSet{
 				{Type: "AWS", Identifiers: "*"},
 			},
 		},
 		"single key, single value": {
 			b: []byte(`{"AWS": "111122223333"}`),
-			want: tfiam.IAMPolicyStatementPrincipalSet{
+			want: iampolicy.StatementPrincipalSet{
 				{Type: "AWS", Identifiers: "111122223333"},
 			},
 		},
 		"single key, multiple value": {
 			b: []byte(`{"AWS": ["111122223333", "444455556666"]}`),
-			want: tfiam.IAMPolicyStatementPrincipalSet{
+			want: iampolicy.StatementPrincipalSet{
 				{Type: "AWS", Identifiers: []string{"111122223333", "444455556666"}},
 			},
 		},
@@ -467,7 +467,7 @@ func TestIAMPolicyStatementPrincipalSet_UnmarshalJSON(t *testing.T) { // nosemgr
   "CanonicalUser": "abcdef123456"
 }`,
 			),
-			want: tfiam.IAMPolicyStatementPrincipalSet{
+			want: iampolicy.StatementPrincipalSet{
 				{Type: "AWS", Identifiers: "111122223333"},
 				{Type: "CanonicalUser", Identifiers: "abcdef123456"},
 			},
@@ -494,7 +494,7 @@ func TestIAMPolicyStatementPrincipalSet_UnmarshalJSON(t *testing.T) { // nosemgr
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			var got tfiam.IAMPolicyStatementPrincipalSet
+			var got iampolicy.StatementPrincipalSet
 			err := got.UnmarshalJSON(tc.b)
 			if (err != nil) != tc.wantErr {
 				t.Errorf("IAMPolicyStatementPrincipalSet.UnmarshalJSON() error = %v, wantErr %t", err, tc.wantErr)
@@ -502,7 +502,7 @@ func TestIAMPolicyStatementPrincipalSet_UnmarshalJSON(t *testing.T) { // nosemgr
 			}
 			// Sort both slices by Type to ensure deterministic comparison
 			// (JSON object key iteration order is non-deterministic)
-			sortByType := func(a, b tfiam.IAMPolicyStatementPrincipal) int {
+			sortByType := func(a, b iampolicy.StatementPrincipal) int {
 				if a.Type < b.Type {
 					return -1
 				}
@@ -519,3 +519,148 @@ func TestIAMPolicyStatementPrincipalSet_UnmarshalJSON(t *testing.T) { // nosemgr
 		})
 	}
 }
+
+func TestNormalize(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		policy  string
+		want    string
+		wantErr bool
+	}{
+		"single element lists": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"AWS": ["arn:aws:iam::123456789012:root"]},
+      "Action": ["s3:GetObject"],
+      "Resource": ["*"]
+    }
+  ]
+}`, // lintignore:AWSAT005
+			want: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Principal":{"AWS":"arn:aws:iam::123456789012:root"}}]}`, // lintignore:AWSAT005
+		},
+		"list ordering": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "Example",
+      "Effect": "Allow",
+      "Action": ["s3:PutObject", "s3:GetObject"],
+      "NotResource": ["arn:aws:s3:::a", "arn:aws:s3:::b"]
+    }
+  ]
+}`, // lintignore:AWSAT005
+			want: `{"Version":"2012-10-17","Statement":[{"Sid":"Example","Effect":"Allow","Action":["s3:PutObject","s3:GetObject"],"NotResource":["arn:aws:s3:::b","arn:aws:s3:::a"]}]}`, // lintignore:AWSAT005
+		},
+		"wildcard principal": {
+			policy: `{"Statement": [{"Effect": "Allow", "Principal": "*", "Action": "sts:AssumeRole"}]}`,
+			want:   `{"Statement":[{"Effect":"Allow","Action":"sts:AssumeRole","Principal":"*"}]}`,
+		},
+		"principal ordering": {
+			policy: `{"Statement": [{"Effect": "Allow", "Principal": {"AWS": ["arn:aws:iam::111111111111:root", "arn:aws:iam::222222222222:root"]}, "Action": "sts:AssumeRole"}]}`, // lintignore:AWSAT005
+			want:   `{"Statement":[{"Effect":"Allow","Action":"sts:AssumeRole","Principal":{"AWS":["arn:aws:iam::222222222222:root","arn:aws:iam::111111111111:root"]}}]}`,         // lintignore:AWSAT005
+		},
+		"invalid JSON": {
+			policy:  `{`,
+			wantErr: true,
+		},
+		"invalid action type": {
+			policy:  `{"Statement": [{"Effect": "Allow", "Action": ["s3:GetObject", 0]}]}`,
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := iampolicy.Normalize(tc.policy)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("Normalize() error = %v, wantErr %t", err, tc.wantErr)
+			}
+			if got != tc.want {
+				t.Errorf("Normalize() = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMerge(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		policies     []string
+		want         string
+		wantErr      bool
+		wantErrIndex int
+	}{
+		"no policies": {
+			want: `{}`,
+		},
+		"statements appended in order": {
+			policies: []string{
+				`{"Version": "2008-10-17", "Statement": [{"Sid": "A", "Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"}]}`,
+				`{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": ["s3:PutObject"], "Resource": "*"}]}`,
+			},
+			want: `{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Effect":"Allow","Action":"s3:PutObject","Resource":"*"}]}`,
+		},
+		"equivalent duplicate Sid": {
+			policies: []string{
+				`{"Version": "2012-10-17", "Statement": [{"Sid": "A", "Effect": "Allow", "Action": ["s3:PutObject", "s3:GetObject"], "Resource": "*"}]}`,
+				`{"Version": "2012-10-17", "Statement": [{"Sid": "A", "Effect": "Allow", "Action": ["s3:GetObject", "s3:PutObject"], "Resource": ["*"]}]}`,
+			},
+			want: `{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Allow","Action":["s3:PutObject","s3:GetObject"],"Resource":"*"}]}`,
+		},
+		"empty policy skipped": {
+			policies: []string{
+				"",
+				`{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"}]}`,
+			},
+			want: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+		},
+		"conflicting Sid": {
+			policies: []string{
+				`{"Version": "2012-10-17", "Statement": [{"Sid": "A", "Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"}]}`,
+				`{"Version": "2012-10-17", "Statement": [{"Sid": "A", "Effect": "Deny", "Action": "s3:GetObject", "Resource": "*"}]}`,
+			},
+			wantErr:      true,
+			wantErrIndex: 1,
+		},
+		"invalid JSON": {
+			policies: []string{
+				`{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"}]}`,
+				"",
+				`{`,
+			},
+			wantErr:      true,
+			wantErrIndex: 2,
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := iampolicy.Merge(tc.policies...)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("Merge() error = %v, wantErr %t", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				docErr, ok := errors.AsType[*iampolicy.DocumentError](err)
+				if !ok {
+					t.Fatalf("Merge() error = %v, want *iampolicy.DocumentError", err)
+				}
+				if docErr.Index != tc.wantErrIndex {
+					t.Errorf("Merge() error index = %d, want %d", docErr.Index, tc.wantErrIndex)
+				}
+			}
+			if got != tc.want {
+				t.Errorf("Merge() = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,169 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationsignals
+
+import (
+	"context"
+	"time"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationsignals"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/applicationsignals/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_applicationsignals_service", name="Service")
+func newServiceDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &serviceDataSource{}, nil
+}
+
+type serviceDataSource struct {
+	framework.DataSourceWithModel[serviceDataSourceModel]
+}
+
+func (d *serviceDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"attribute_maps": schema.ListAttribute{
+				ElementType: types.MapType{ElemType: types.StringType},
+				Computed:    true,
+			},
+			"end_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Optional:   true,
+				Computed:   true,
+			},
+			"key_attributes": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"log_group_references": schema.ListAttribute{
+				ElementType: types.MapType{ElemType: types.StringType},
+				Computed:    true,
+			},
+			"metric_references": framework.DataSourceComputedListOfObjectAttribute[metricReferenceModel](ctx),
+			"service_groups":    framework.DataSourceComputedListOfObjectAttribute[serviceGroupModel](ctx),
+			names.AttrStartTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Optional:   true,
+				Computed:   true,
+			},
+		},
+	}
+}
+
+func (d *serviceDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data serviceDataSourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().ApplicationSignalsClient(ctx)
+
+	var input applicationsignals.GetServiceInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, data, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The service is looked up over the last day unless a time range is configured.
+	if input.EndTime == nil {
+		input.EndTime = aws.Time(time.Now())
+	}
+	if input.StartTime == nil {
+		input.StartTime = aws.Time(input.EndTime.Add(-24 * time.Hour))
+	}
+
+	output, err := findService(ctx, conn, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output.Service, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	attributeMaps, diags := types.ListValueFrom(ctx, types.MapType{ElemType: types.StringType}, output.Service.AttributeMaps)
+	smerr.AddEnrich(ctx, &response.Diagnostics, diags)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.AttributeMaps = attributeMaps
+
+	logGroupReferences, diags := types.ListValueFrom(ctx, types.MapType{ElemType: types.StringType}, output.Service.LogGroupReferences)
+	smerr.AddEnrich(ctx, &response.Diagnostics, diags)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.LogGroupReferences = logGroupReferences
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func findService(ctx context.Context, conn *applicationsignals.Client, input *applicationsignals.GetServiceInput) (*applicationsignals.GetServiceOutput, error) {
+	output, err := conn.GetService(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil || output.Service == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError())
+	}
+
+	return output, nil
+}
+
+type serviceDataSourceModel struct {
+	framework.WithRegionModel
+	AttributeMaps      types.List                                            `tfsdk:"attribute_maps" autoflex:"-"`
+	EndTime            timetypes.RFC3339                                     `tfsdk:"end_time"`
+	KeyAttributes      fwtypes.MapOfString                                   `tfsdk:"key_attributes"`
+	LogGroupReferences types.List                                            `tfsdk:"log_group_references" autoflex:"-"`
+	MetricReferences   fwtypes.ListNestedObjectValueOf[metricReferenceModel] `tfsdk:"metric_references"`
+	ServiceGroups      fwtypes.ListNestedObjectValueOf[serviceGroupModel]    `tfsdk:"service_groups"`
+	StartTime          timetypes.RFC3339                                     `tfsdk:"start_time"`
+}
+
+type metricReferenceModel struct {
+	AccountID  types.String                                    `tfsdk:"account_id"`
+	Dimensions fwtypes.ListNestedObjectValueOf[dimensionModel] `tfsdk:"dimensions"`
+	MetricName types.String                                    `tfsdk:"metric_name"`
+	MetricType types.String                                    `tfsdk:"metric_type"`
+	Namespace  types.String                                    `tfsdk:"namespace"`
+}
+
+type serviceGroupModel struct {
+	GroupIdentifier types.String `tfsdk:"group_identifier"`
+	GroupName       types.String `tfsdk:"group_name"`
+	GroupSource     types.String `tfsdk:"group_source"`
+	GroupValue      types.String `tfsdk:"group_value"`
+}
@@ -0,0 +1,67 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationsignals_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Services are discovered from the telemetry of instrumented applications, so this test only runs
+// when the name and environment of a service already known to Application Signals are set.
+const (
+	envVarServiceName        = "APPLICATIONSIGNALS_SERVICE_NAME"
+	envVarServiceEnvironment = "APPLICATIONSIGNALS_SERVICE_ENVIRONMENT"
+)
+
+func TestAccApplicationSignalsServiceDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	serviceName := acctest.SkipIfEnvVarNotSet(t, envVarServiceName)
+	environment := acctest.SkipIfEnvVarNotSet(t, envVarServiceEnvironment)
+	dataSourceName := "data.aws_applicationsignals_service.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationSignalsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceDataSourceConfig_basic(serviceName, environment),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(dataSourceName, tfjsonpath.New("attribute_maps"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(dataSourceName, tfjsonpath.New("end_time"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(dataSourceName, tfjsonpath.New("key_attributes"), knownvalue.MapExact(map[string]knownvalue.Check{
+						"Environment": knownvalue.StringExact(environment),
+						"Name":        knownvalue.StringExact(serviceName),
+						"Type":        knownvalue.StringExact("Service"),
+					})),
+					statecheck.ExpectKnownValue(dataSourceName, tfjsonpath.New("metric_references"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(dataSourceName, tfjsonpath.New(names.AttrStartTime), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func testAccServiceDataSourceConfig_basic(serviceName, environment string) string {
+	return fmt.Sprintf(`
+data "aws_applicationsignals_service" "test" {
+  key_attributes = {
+    Environment = %[2]q
+    Name        = %[1]q
+    Type        = "Service"
+  }
+}
+`, serviceName, environment)
+}
@@ -0,0 +1,473 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationsignals_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/applicationsignals"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/applicationsignals/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfknownvalue "github.com/hashicorp/terraform-provider-aws/internal/acctest/knownvalue"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfapplicationsignals "github.com/hashicorp/terraform-provider-aws/internal/service/applicationsignals"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccApplicationSignalsServiceLevelObjective_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ServiceLevelObjective
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_applicationsignals_service_level_objective.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationSignalsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceLevelObjectiveDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLevelObjectiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, t, resourceName, &v),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrARN), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("evaluation_type"), tfknownvalue.StringExact(awstypes.EvaluationTypePeriodBased)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("goal"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"attainment_goal": knownvalue.Float64Exact(99),
+							names.AttrInterval: knownvalue.ListExact([]knownvalue.Check{
+								knownvalue.ObjectExact(map[string]knownvalue.Check{
+									"calendar_interval": knownvalue.ListExact([]knownvalue.Check{}),
+									"rolling_interval": knownvalue.ListExact([]knownvalue.Check{
+										knownvalue.ObjectExact(map[string]knownvalue.Check{
+											names.AttrDuration: knownvalue.Int32Exact(7),
+											"duration_unit":    tfknownvalue.StringExact(awstypes.DurationUnitDay),
+										}),
+									}),
+								}),
+							}),
+							"warning_threshold": knownvalue.Float64Exact(50),
+						}),
+					})),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrName), knownvalue.StringExact(rName)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("request_based_sli"), knownvalue.ListExact([]knownvalue.Check{})),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("sli"), knownvalue.ListSizeExact(1)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.Null()),
+				},
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrARN),
+				ImportStateVerifyIdentifierAttribute: names.AttrARN,
+			},
+		},
+	})
+}
+
+func TestAccApplicationSignalsServiceLevelObjective_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ServiceLevelObjective
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_applicationsignals_service_level_objective.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationSignalsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceLevelObjectiveDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLevelObjectiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, t, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, t, tfapplicationsignals.ResourceServiceLevelObjective, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccApplicationSignalsServiceLevelObjective_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ServiceLevelObjective
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_applicationsignals_service_level_objective.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationSignalsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceLevelObjectiveDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLevelObjectiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, t, resourceName, &v),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("burn_rate_configuration"), knownvalue.ListExact([]knownvalue.Check{})),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrDescription), knownvalue.Null()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("exclusion_window"), knownvalue.SetExact([]knownvalue.Check{})),
+				},
+			},
+			{
+				Config: testAccServiceLevelObjectiveConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, t, resourceName, &v),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("burn_rate_configuration"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"look_back_window_minutes": knownvalue.Int32Exact(60),
+						}),
+					})),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrDescription), knownvalue.StringExact("Updated SLO")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("exclusion_window"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"reason": knownvalue.StringExact("Maintenance"),
+							"recurrence_rule": knownvalue.ListExact([]knownvalue.Check{
+								knownvalue.ObjectExact(map[string]knownvalue.Check{
+									names.AttrExpression: knownvalue.StringExact("cron(0 2 ? * SUN *)"),
+								}),
+							}),
+							names.AttrStartTime: knownvalue.Null(),
+							"window": knownvalue.ListExact([]knownvalue.Check{
+								knownvalue.ObjectExact(map[string]knownvalue.Check{
+									names.AttrDuration: knownvalue.Int32Exact(2),
+									"duration_unit":    tfknownvalue.StringExact(awstypes.DurationUnitHour),
+								}),
+							}),
+						}),
+					})),
+				},
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrARN),
+				ImportStateVerifyIdentifierAttribute: names.AttrARN,
+			},
+		},
+	})
+}
+
+func TestAccApplicationSignalsServiceLevelObjective_requestBased(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ServiceLevelObjective
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_applicationsignals_service_level_objective.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationSignalsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceLevelObjectiveDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLevelObjectiveConfig_requestBased(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, t, resourceName, &v),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("evaluation_type"), tfknownvalue.StringExact(awstypes.EvaluationTypeRequestBased)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("request_based_sli").AtSliceIndex(0).AtMapKey("request_based_sli_metric").AtSliceIndex(0).AtMapKey("monitored_request_count_metric"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"bad_count_metric":  knownvalue.ListSizeExact(1),
+							"good_count_metric": knownvalue.ListExact([]knownvalue.Check{}),
+						}),
+					})),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("sli"), knownvalue.ListExact([]knownvalue.Check{})),
+				},
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrARN),
+				ImportStateVerifyIdentifierAttribute: names.AttrARN,
+			},
+		},
+	})
+}
+
+func testAccCheckServiceLevelObjectiveDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).ApplicationSignalsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_applicationsignals_service_level_objective" {
+				continue
+			}
+
+			_, err := tfapplicationsignals.FindServiceLevelObjectiveByID(ctx, conn, rs.Primary.Attributes[names.AttrARN])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Application Signals Service Level Objective %s still exists", rs.Primary.Attributes[names.AttrARN])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckServiceLevelObjectiveExists(ctx context.Context, t *testing.T, n string, v *awstypes.ServiceLevelObjective) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).ApplicationSignalsClient(ctx)
+
+		output, err := tfapplicationsignals.FindServiceLevelObjectiveByID(ctx, conn, rs.Primary.Attributes[names.AttrARN])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.ProviderMeta(ctx, t).ApplicationSignalsClient(ctx)
+
+	var input applicationsignals.ListServiceLevelObjectivesInput
+	_, err := conn.ListServiceLevelObjectives(ctx, &input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccServiceLevelObjectiveConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_applicationsignals_service_level_objective" "test" {
+  name = %[1]q
+
+  goal {
+    attainment_goal   = 99
+    warning_threshold = 50
+
+    interval {
+      rolling_interval {
+        duration      = 7
+        duration_unit = "DAY"
+      }
+    }
+  }
+
+  sli {
+    comparison_operator = "LessThan"
+    metric_threshold    = 90
+
+    sli_metric {
+      metric_data_query {
+        id          = "cpu"
+        return_data = true
+
+        metric_stat {
+          period = 60
+          stat   = "Average"
+
+          metric {
+            metric_name = "CPUUtilization"
+            namespace   = "AWS/EC2"
+
+            dimension {
+              name  = "AutoScalingGroupName"
+              value = %[1]q
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccServiceLevelObjectiveConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_applicationsignals_service_level_objective" "test" {
+  name        = %[1]q
+  description = "Updated SLO"
+
+  burn_rate_configuration {
+    look_back_window_minutes = 60
+  }
+
+  exclusion_window {
+    reason = "Maintenance"
+
+    recurrence_rule {
+      expression = "cron(0 2 ? * SUN *)"
+    }
+
+    window {
+      duration      = 2
+      duration_unit = "HOUR"
+    }
+  }
+
+  goal {
+    attainment_goal   = 99
+    warning_threshold = 50
+
+    interval {
+      rolling_interval {
+        duration      = 7
+        duration_unit = "DAY"
+      }
+    }
+  }
+
+  sli {
+    comparison_operator = "LessThan"
+    metric_threshold    = 80
+
+    sli_metric {
+      metric_data_query {
+        id          = "cpu"
+        return_data = true
+
+        metric_stat {
+          period = 60
+          stat   = "Average"
+
+          metric {
+            metric_name = "CPUUtilization"
+            namespace   = "AWS/EC2"
+
+            dimension {
+              name  = "AutoScalingGroupName"
+              value = %[1]q
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccServiceLevelObjectiveConfig_requestBased(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_applicationsignals_service_level_objective" "test" {
+  name = %[1]q
+
+  goal {
+    attainment_goal = 99.9
+
+    interval {
+      calendar_interval {
+        duration      = 1
+        duration_unit = "MONTH"
+        start_time    = "2025-01-01T00:00:00Z"
+      }
+    }
+  }
+
+  request_based_sli {
+    request_based_sli_metric {
+      monitored_request_count_metric {
+        bad_count_metric {
+          id          = "errors"
+          return_data = true
+
+          metric_stat {
+            period = 60
+            stat   = "Sum"
+
+            metric {
+              metric_name = "Errors"
+              namespace   = "AWS/Lambda"
+
+              dimension {
+                name  = "FunctionName"
+                value = %[1]q
+              }
+            }
+          }
+        }
+      }
+
+      total_request_count_metric {
+        id          = "invocations"
+        return_data = true
+
+        metric_stat {
+          period = 60
+          stat   = "Sum"
+
+          metric {
+            metric_name = "Invocations"
+            namespace   = "AWS/Lambda"
+
+            dimension {
+              name  = "FunctionName"
+              value = %[1]q
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
@@ -0,0 +1,59 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_applicationsignals_service_level_objective" "test" {
+  region = var.region
+
+  name = var.rName
+
+  goal {
+    attainment_goal   = 99
+    warning_threshold = 50
+
+    interval {
+      rolling_interval {
+        duration      = 7
+        duration_unit = "DAY"
+      }
+    }
+  }
+
+  sli {
+    comparison_operator = "LessThan"
+    metric_threshold    = 90
+
+    sli_metric {
+      metric_data_query {
+        id          = "cpu"
+        return_data = true
+
+        metric_stat {
+          period = 60
+          stat   = "Average"
+
+          metric {
+            metric_name = "CPUUtilization"
+            namespace   = "AWS/EC2"
+
+            dimension {
+              name  = "AutoScalingGroupName"
+              value = var.rName
+            }
+          }
+        }
+      }
+    }
+  }
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "region" {
+  description = "Region to deploy resource in"
+  type        = string
+  nullable    = false
+}
@@ -0,0 +1,60 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_applicationsignals_service_level_objective" "test" {
+  name = var.rName
+
+  goal {
+    attainment_goal   = 99
+    warning_threshold = 50
+
+    interval {
+      rolling_interval {
+        duration      = 7
+        duration_unit = "DAY"
+      }
+    }
+  }
+
+  sli {
+    comparison_operator = "LessThan"
+    metric_threshold    = 90
+
+    sli_metric {
+      metric_data_query {
+        id          = "cpu"
+        return_data = true
+
+        metric_stat {
+          period = 60
+          stat   = "Average"
+
+          metric {
+            metric_name = "CPUUtilization"
+            namespace   = "AWS/EC2"
+
+            dimension {
+              name  = "AutoScalingGroupName"
+              value = var.rName
+            }
+          }
+        }
+      }
+    }
+  }
+
+  tags = var.resource_tags
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "resource_tags" {
+  description = "Tags to set on resource. To specify no tags, set to `null`"
+  # Not setting a default, so that this must explicitly be set to `null` to specify no tags
+  type     = map(string)
+  nullable = true
+}
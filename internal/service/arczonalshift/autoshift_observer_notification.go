@@ -0,0 +1,143 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package arczonalshift
+
+import (
+	"context"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/service/arczonalshift"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/arczonalshift/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_arczonalshift_autoshift_observer_notification", name="Autoshift Observer Notification")
+// @SingletonIdentity(identityDuplicateAttributes="id")
+// @Testing(serialize=true, hasNoPreExistingResource=true, generator=false)
+// @Testing(preCheck="testAccPreCheck")
+func newAutoshiftObserverNotificationResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &autoshiftObserverNotificationResource{}
+
+	return r, nil
+}
+
+type autoshiftObserverNotificationResource struct {
+	framework.ResourceWithModel[autoshiftObserverNotificationResourceModel]
+	framework.WithNoUpdate
+	framework.WithImportByIdentity
+}
+
+func (r *autoshiftObserverNotificationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttributeDeprecatedWithAlternate(path.Root(names.AttrRegion)),
+		},
+	}
+}
+
+func (r *autoshiftObserverNotificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data autoshiftObserverNotificationResourceModel
+	smerr.AddEnrich(ctx, &resp.Diagnostics, req.Plan.Get(ctx, &data))
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ARCZonalShiftClient(ctx)
+
+	if err := updateAutoshiftObserverNotificationStatus(ctx, conn, awstypes.AutoshiftObserverNotificationStatusEnabled); err != nil {
+		smerr.AddError(ctx, &resp.Diagnostics, err, "operation", "enabling ARC Zonal Shift Autoshift Observer Notification")
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringValueToFramework(ctx, r.Meta().Region(ctx))
+
+	smerr.AddEnrich(ctx, &resp.Diagnostics, resp.State.Set(ctx, &data))
+}
+
+func (r *autoshiftObserverNotificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data autoshiftObserverNotificationResourceModel
+	smerr.AddEnrich(ctx, &resp.Diagnostics, req.State.Get(ctx, &data))
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ARCZonalShiftClient(ctx)
+
+	_, err := findAutoshiftObserverNotification(ctx, conn)
+	if retry.NotFound(err) {
+		smerr.AddOne(ctx, &resp.Diagnostics, fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		smerr.AddError(ctx, &resp.Diagnostics, err, "operation", "reading ARC Zonal Shift Autoshift Observer Notification")
+		return
+	}
+
+	// Set attributes for import.
+	data.ID = fwflex.StringValueToFramework(ctx, r.Meta().Region(ctx))
+
+	smerr.AddEnrich(ctx, &resp.Diagnostics, resp.State.Set(ctx, &data))
+}
+
+func (r *autoshiftObserverNotificationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data autoshiftObserverNotificationResourceModel
+	smerr.AddEnrich(ctx, &resp.Diagnostics, req.State.Get(ctx, &data))
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ARCZonalShiftClient(ctx)
+
+	if err := updateAutoshiftObserverNotificationStatus(ctx, conn, awstypes.AutoshiftObserverNotificationStatusDisabled); err != nil {
+		smerr.AddError(ctx, &resp.Diagnostics, err, "operation", "disabling ARC Zonal Shift Autoshift Observer Notification")
+		return
+	}
+}
+
+func updateAutoshiftObserverNotificationStatus(ctx context.Context, conn *arczonalshift.Client, status awstypes.AutoshiftObserverNotificationStatus) error {
+	input := arczonalshift.UpdateAutoshiftObserverNotificationStatusInput{
+		Status: status,
+	}
+	_, err := conn.UpdateAutoshiftObserverNotificationStatus(ctx, &input)
+
+	return err
+}
+
+func findAutoshiftObserverNotification(ctx context.Context, conn *arczonalshift.Client) (*arczonalshift.GetAutoshiftObserverNotificationStatusOutput, error) {
+	var input arczonalshift.GetAutoshiftObserverNotificationStatusInput
+	output, err := conn.GetAutoshiftObserverNotificationStatus(ctx, &input)
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError())
+	}
+
+	if status := output.Status; status == awstypes.AutoshiftObserverNotificationStatusDisabled {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			Message: string(status),
+		})
+	}
+
+	return output, nil
+}
+
+type autoshiftObserverNotificationResourceModel struct {
+	framework.WithRegionModel
+	ID types.String `tfsdk:"id"`
+}
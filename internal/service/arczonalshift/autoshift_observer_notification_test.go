@@ -0,0 +1,147 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package arczonalshift_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfarczonalshift "github.com/hashicorp/terraform-provider-aws/internal/service/arczonalshift"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccARCZonalShiftAutoshiftObserverNotification_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]func(t *testing.T){
+		acctest.CtBasic:      testAccAutoshiftObserverNotification_basic,
+		acctest.CtDisappears: testAccAutoshiftObserverNotification_disappears,
+		"Identity":           testAccARCZonalShiftAutoshiftObserverNotification_identitySerial,
+	}
+
+	acctest.RunSerialTests1Level(t, testCases, 0)
+}
+
+func testAccAutoshiftObserverNotification_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_arczonalshift_autoshift_observer_notification.test"
+
+	acctest.Test(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ARCZonalShiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutoshiftObserverNotificationDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutoshiftObserverNotificationConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAutoshiftObserverNotificationExists(ctx, t, resourceName),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.Region())),
+				},
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAutoshiftObserverNotification_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_arczonalshift_autoshift_observer_notification.test"
+
+	acctest.Test(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ARCZonalShiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutoshiftObserverNotificationDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutoshiftObserverNotificationConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAutoshiftObserverNotificationExists(ctx, t, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, t, tfarczonalshift.ResourceAutoshiftObserverNotification, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckAutoshiftObserverNotificationDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).ARCZonalShiftClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_arczonalshift_autoshift_observer_notification" {
+				continue
+			}
+
+			_, err := tfarczonalshift.FindAutoshiftObserverNotification(ctx, conn)
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return errors.New("ARC Zonal Shift Autoshift Observer Notification still enabled")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAutoshiftObserverNotificationExists(ctx context.Context, t *testing.T, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).ARCZonalShiftClient(ctx)
+
+		_, err := tfarczonalshift.FindAutoshiftObserverNotification(ctx, conn)
+
+		return err
+	}
+}
+
+func testAccAutoshiftObserverNotificationConfig_basic() string {
+	return `
+resource "aws_arczonalshift_autoshift_observer_notification" "test" {}
+`
+}
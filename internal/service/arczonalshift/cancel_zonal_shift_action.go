@@ -0,0 +1,171 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package arczonalshift
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/arczonalshift"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/arczonalshift/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_arczonalshift_cancel_zonal_shift, name="Cancel Zonal Shift")
+func newCancelZonalShiftAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &cancelZonalShiftAction{}, nil
+}
+
+var (
+	_ action.Action = (*cancelZonalShiftAction)(nil)
+)
+
+type cancelZonalShiftAction struct {
+	framework.ActionWithModel[cancelZonalShiftActionModel]
+}
+
+type cancelZonalShiftActionModel struct {
+	framework.WithRegionModel
+	ResourceARN  fwtypes.ARN  `tfsdk:"resource_arn"`
+	Timeout      types.Int64  `tfsdk:"timeout"`
+	ZonalShiftID types.String `tfsdk:"zonal_shift_id"`
+}
+
+func (a *cancelZonalShiftAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Cancels an ARC zonal shift, restoring traffic to the Availability Zone. Either a zonal shift ID or a managed resource ARN can be specified; with a resource ARN, all active customer-initiated zonal shifts for the resource are canceled.",
+		Attributes: map[string]schema.Attribute{
+			names.AttrResourceARN: schema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Description: "The ARN of the managed resource whose active zonal shifts are canceled",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot(names.AttrResourceARN), path.MatchRoot("zonal_shift_id")),
+				},
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the zonal shift to be removed from the resource (default: 300)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(30),
+					int64validator.AtMost(3600),
+				},
+			},
+			"zonal_shift_id": schema.StringAttribute{
+				Description: "The ID of the zonal shift to cancel",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (a *cancelZonalShiftAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config cancelZonalShiftActionModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get AWS client
+	conn := a.Meta().ARCZonalShiftClient(ctx)
+
+	resourceARN := fwflex.StringValueFromFramework(ctx, config.ResourceARN)
+	zonalShiftID := fwflex.StringValueFromFramework(ctx, config.ZonalShiftID)
+
+	// Set default timeout if not provided
+	timeout := fwactions.TimeoutOr(config.Timeout, 300*time.Second)
+
+	tflog.Info(ctx, "Starting ARC cancel zonal shift action", map[string]any{
+		names.AttrResourceARN: resourceARN,
+		"zonal_shift_id":      zonalShiftID,
+		names.AttrTimeout:     timeout.String(),
+	})
+
+	// Send initial progress update
+	cb := fwactions.NewSendProgressFunc(resp)
+
+	var zonalShiftIDs []string
+	if zonalShiftID != "" {
+		zonalShiftIDs = append(zonalShiftIDs, zonalShiftID)
+	} else {
+		cb(ctx, "Finding active zonal shifts for %s...", resourceARN)
+
+		zonalShifts, err := findActiveZonalShiftsByResourceARN(ctx, conn, resourceARN)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to List Zonal Shifts",
+				fmt.Sprintf("Could not list zonal shifts for %s: %s", resourceARN, err),
+			)
+			return
+		}
+
+		if len(zonalShifts) == 0 {
+			cb(ctx, "No active zonal shifts found for %s", resourceARN)
+			tflog.Info(ctx, "No active zonal shifts to cancel", map[string]any{
+				names.AttrResourceARN: resourceARN,
+			})
+			return
+		}
+
+		for _, v := range zonalShifts {
+			zonalShiftIDs = append(zonalShiftIDs, aws.ToString(v.ZonalShiftId))
+		}
+	}
+
+	for _, id := range zonalShiftIDs {
+		cb(ctx, "Canceling zonal shift %s...", id)
+
+		input := arczonalshift.CancelZonalShiftInput{
+			ZonalShiftId: aws.String(id),
+		}
+
+		output, err := conn.CancelZonalShift(ctx, &input)
+		if isConflictExceptionWithReason(err, awstypes.ConflictExceptionReasonZonalShiftStatusNotActive) {
+			cb(ctx, "Zonal shift %s is no longer active", id)
+			continue
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to Cancel Zonal Shift",
+				fmt.Sprintf("Could not cancel zonal shift %s: %s", id, err),
+			)
+			return
+		}
+
+		cb(ctx, "Zonal shift %s canceled, waiting for traffic to be restored...", id)
+
+		err = waitZonalShiftActionStatus(ctx, conn, aws.ToString(output.ResourceIdentifier), id, timeout, cb,
+			zonalShiftStatusNotFound,
+			string(awstypes.AppliedStatusApplied),
+			string(awstypes.AppliedStatusNotApplied),
+		)
+		if err != nil {
+			addZonalShiftActionWaitError(&resp.Diagnostics, err, id, "Cancel", timeout)
+			return
+		}
+
+		cb(ctx, "Zonal shift %s has been successfully canceled", id)
+	}
+
+	tflog.Info(ctx, "ARC cancel zonal shift action completed successfully", map[string]any{
+		names.AttrResourceARN: resourceARN,
+		"zonal_shift_ids":     zonalShiftIDs,
+	})
+}
@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package arczonalshift_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccARCZonalShiftCancelZonalShiftAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_lb.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ARCZonalShiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCancelZonalShiftActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckActiveZonalShiftCount(ctx, t, resourceName, 0),
+				),
+			},
+		},
+	})
+}
+
+func testAccCancelZonalShiftActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccZonalShiftActionConfig_base(rName), `
+action "aws_arczonalshift_start_zonal_shift" "test" {
+  config {
+    resource_arn = aws_lb.test.arn
+    away_from    = aws_subnet.test[0].availability_zone_id
+    expires_in   = "10m"
+    comment      = "Terraform acceptance test"
+  }
+}
+
+action "aws_arczonalshift_cancel_zonal_shift" "test" {
+  config {
+    resource_arn = aws_lb.test.arn
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_arczonalshift_start_zonal_shift.test, action.aws_arczonalshift_cancel_zonal_shift.test]
+    }
+  }
+
+  depends_on = [aws_lb.test]
+}
+`)
+}
@@ -0,0 +1,14 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package arczonalshift
+
+// Exports for use in tests only.
+var (
+	ResourceAutoshiftObserverNotification = newAutoshiftObserverNotificationResource
+	ResourcePracticeRunConfiguration      = newPracticeRunConfigurationResource
+
+	FindActiveZonalShiftsByResourceARN = findActiveZonalShiftsByResourceARN
+	FindAutoshiftObserverNotification  = findAutoshiftObserverNotification
+	FindPracticeRunConfigurationByARN  = findPracticeRunConfigurationByARN
+)
@@ -0,0 +1,338 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package arczonalshift
+
+import (
+	"context"
+	"errors"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/arczonalshift"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/arczonalshift/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_arczonalshift_practice_run_configuration", name="Practice Run Configuration")
+// @ArnIdentity("resource_arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/arczonalshift/types;awstypes;awstypes.PracticeRunConfiguration")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(hasNoPreExistingResource=true)
+func newPracticeRunConfigurationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &practiceRunConfigurationResource{}
+
+	return r, nil
+}
+
+type practiceRunConfigurationResource struct {
+	framework.ResourceWithModel[practiceRunConfigurationResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *practiceRunConfigurationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"allowed_windows": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"blocked_dates": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"blocked_windows": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrResourceARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"blocking_alarms": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[controlConditionModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: controlConditionAttributes(),
+				},
+			},
+			"outcome_alarms": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[controlConditionModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: controlConditionAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func controlConditionAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"alarm_identifier": schema.StringAttribute{
+			CustomType: fwtypes.ARNType,
+			Required:   true,
+		},
+		names.AttrType: schema.StringAttribute{
+			CustomType: fwtypes.StringEnumType[awstypes.ControlConditionType](),
+			Required:   true,
+		},
+	}
+}
+
+func (r *practiceRunConfigurationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan practiceRunConfigurationResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ARCZonalShiftClient(ctx)
+
+	resourceARN := fwflex.StringValueFromFramework(ctx, plan.ResourceARN)
+	var input arczonalshift.CreatePracticeRunConfigurationInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, plan, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ResourceIdentifier = aws.String(resourceARN)
+
+	output, err := conn.CreatePracticeRunConfiguration(ctx, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, resourceARN)
+		return
+	}
+
+	// Set values for unknowns.
+	plan.Name = fwflex.StringToFramework(ctx, output.Name)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, plan))
+}
+
+func (r *practiceRunConfigurationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data practiceRunConfigurationResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ARCZonalShiftClient(ctx)
+
+	resourceARN := fwflex.StringValueFromFramework(ctx, data.ResourceARN)
+	output, err := findManagedResourceWithPracticeRunConfigurationByARN(ctx, conn, resourceARN)
+
+	if retry.NotFound(err) {
+		smerr.AddOne(ctx, &response.Diagnostics, fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, resourceARN)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output.PracticeRunConfiguration, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.Name = fwflex.StringToFramework(ctx, output.Name)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *practiceRunConfigurationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old practiceRunConfigurationResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &new))
+	if response.Diagnostics.HasError() {
+		return
+	}
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &old))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ARCZonalShiftClient(ctx)
+
+	diff, d := fwflex.Diff(ctx, new, old)
+	smerr.AddEnrich(ctx, &response.Diagnostics, d)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		resourceARN := fwflex.StringValueFromFramework(ctx, new.ResourceARN)
+		var input arczonalshift.UpdatePracticeRunConfigurationInput
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, new, &input))
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.ResourceIdentifier = aws.String(resourceARN)
+
+		// Omitted fields are left unchanged, so removed values must be sent as empty lists.
+		if input.AllowedWindows == nil {
+			input.AllowedWindows = []string{}
+		}
+		if input.BlockedDates == nil {
+			input.BlockedDates = []string{}
+		}
+		if input.BlockedWindows == nil {
+			input.BlockedWindows = []string{}
+		}
+		if input.BlockingAlarms == nil {
+			input.BlockingAlarms = []awstypes.ControlCondition{}
+		}
+
+		_, err := conn.UpdatePracticeRunConfiguration(ctx, &input)
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, resourceARN)
+			return
+		}
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &new))
+}
+
+func (r *practiceRunConfigurationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data practiceRunConfigurationResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ARCZonalShiftClient(ctx)
+
+	resourceARN := fwflex.StringValueFromFramework(ctx, data.ResourceARN)
+	input := arczonalshift.DeletePracticeRunConfigurationInput{
+		ResourceIdentifier: aws.String(resourceARN),
+	}
+	_, err := conn.DeletePracticeRunConfiguration(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) || isConflictExceptionWithReason(err, awstypes.ConflictExceptionReasonPracticeConfigurationDoesNotExist) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, resourceARN)
+		return
+	}
+}
+
+func findManagedResourceByARN(ctx context.Context, conn *arczonalshift.Client, arn string) (*arczonalshift.GetManagedResourceOutput, error) {
+	input := arczonalshift.GetManagedResourceInput{
+		ResourceIdentifier: aws.String(arn),
+	}
+	output, err := conn.GetManagedResource(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError())
+	}
+
+	return output, nil
+}
+
+func findManagedResourceWithPracticeRunConfigurationByARN(ctx context.Context, conn *arczonalshift.Client, arn string) (*arczonalshift.GetManagedResourceOutput, error) {
+	output, err := findManagedResourceByARN(ctx, conn, arn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.PracticeRunConfiguration == nil {
+		return nil, smarterr.NewError(&retry.NotFoundError{})
+	}
+
+	return output, nil
+}
+
+func findPracticeRunConfigurationByARN(ctx context.Context, conn *arczonalshift.Client, arn string) (*awstypes.PracticeRunConfiguration, error) {
+	output, err := findManagedResourceWithPracticeRunConfigurationByARN(ctx, conn, arn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output.PracticeRunConfiguration, nil
+}
+
+func isConflictExceptionWithReason(err error, reason awstypes.ConflictExceptionReason) bool {
+	if v, ok := errors.AsType[*awstypes.ConflictException](err); ok {
+		return v.Reason == reason
+	}
+
+	return false
+}
+
+type practiceRunConfigurationResourceModel struct {
+	framework.WithRegionModel
+	AllowedWindows fwtypes.SetOfString                                    `tfsdk:"allowed_windows"`
+	BlockedDates   fwtypes.SetOfString                                    `tfsdk:"blocked_dates"`
+	BlockedWindows fwtypes.SetOfString                                    `tfsdk:"blocked_windows"`
+	BlockingAlarms fwtypes.ListNestedObjectValueOf[controlConditionModel] `tfsdk:"blocking_alarms"`
+	Name           types.String                                           `tfsdk:"name"`
+	OutcomeAlarms  fwtypes.ListNestedObjectValueOf[controlConditionModel] `tfsdk:"outcome_alarms"`
+	ResourceARN    fwtypes.ARN                                            `tfsdk:"resource_arn"`
+}
+
+type controlConditionModel struct {
+	AlarmIdentifier fwtypes.ARN                                       `tfsdk:"alarm_identifier"`
+	Type            fwtypes.StringEnum[awstypes.ControlConditionType] `tfsdk:"type"`
+}
@@ -0,0 +1,304 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package arczonalshift_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/arczonalshift"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/arczonalshift/types"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfknownvalue "github.com/hashicorp/terraform-provider-aws/internal/acctest/knownvalue"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfarczonalshift "github.com/hashicorp/terraform-provider-aws/internal/service/arczonalshift"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccARCZonalShiftPracticeRunConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.PracticeRunConfiguration
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_arczonalshift_practice_run_configuration.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ARCZonalShiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPracticeRunConfigurationDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPracticeRunConfigurationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPracticeRunConfigurationExists(ctx, t, resourceName, &v),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("allowed_windows"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("blocked_dates"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("blocked_windows"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrName), knownvalue.StringExact(rName)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("outcome_alarms"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"alarm_identifier": knownvalue.NotNull(),
+							names.AttrType:     tfknownvalue.StringExact(awstypes.ControlConditionTypeCloudwatch),
+						}),
+					})),
+					statecheck.CompareValuePairs(resourceName, tfjsonpath.New(names.AttrResourceARN), "aws_lb.test", tfjsonpath.New(names.AttrARN), compare.ValuesSame()),
+				},
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrResourceARN),
+				ImportStateVerifyIdentifierAttribute: names.AttrResourceARN,
+			},
+		},
+	})
+}
+
+func TestAccARCZonalShiftPracticeRunConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.PracticeRunConfiguration
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_arczonalshift_practice_run_configuration.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ARCZonalShiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPracticeRunConfigurationDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPracticeRunConfigurationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPracticeRunConfigurationExists(ctx, t, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, t, tfarczonalshift.ResourcePracticeRunConfiguration, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccARCZonalShiftPracticeRunConfiguration_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2, v3 awstypes.PracticeRunConfiguration
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_arczonalshift_practice_run_configuration.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ARCZonalShiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPracticeRunConfigurationDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPracticeRunConfigurationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPracticeRunConfigurationExists(ctx, t, resourceName, &v1),
+				),
+			},
+			{
+				Config: testAccPracticeRunConfigurationConfig_full(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPracticeRunConfigurationExists(ctx, t, resourceName, &v2),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("blocked_dates"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact("2030-01-01"),
+					})),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("blocked_windows"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact("Mon:00:00-Mon:08:00"),
+						knownvalue.StringExact("Sat:00:00-Sat:23:59"),
+					})),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("blocking_alarms"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"alarm_identifier": knownvalue.NotNull(),
+							names.AttrType:     tfknownvalue.StringExact(awstypes.ControlConditionTypeCloudwatch),
+						}),
+					})),
+				},
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrResourceARN),
+				ImportStateVerifyIdentifierAttribute: names.AttrResourceARN,
+			},
+			{
+				Config: testAccPracticeRunConfigurationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPracticeRunConfigurationExists(ctx, t, resourceName, &v3),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("blocked_dates"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("blocked_windows"), knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckPracticeRunConfigurationDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).ARCZonalShiftClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_arczonalshift_practice_run_configuration" {
+				continue
+			}
+
+			_, err := tfarczonalshift.FindPracticeRunConfigurationByARN(ctx, conn, rs.Primary.Attributes[names.AttrResourceARN])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("ARC Zonal Shift Practice Run Configuration %s still exists", rs.Primary.Attributes[names.AttrResourceARN])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPracticeRunConfigurationExists(ctx context.Context, t *testing.T, n string, v *awstypes.PracticeRunConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).ARCZonalShiftClient(ctx)
+
+		output, err := tfarczonalshift.FindPracticeRunConfigurationByARN(ctx, conn, rs.Primary.Attributes[names.AttrResourceARN])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.ProviderMeta(ctx, t).ARCZonalShiftClient(ctx)
+
+	var input arczonalshift.ListZonalShiftsInput
+	_, err := conn.ListZonalShifts(ctx, &input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccPracticeRunConfigurationConfig_base(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
+resource "aws_lb" "test" {
+  name               = %[1]q
+  internal           = true
+  load_balancer_type = "network"
+  subnets            = aws_subnet.test[*].id
+  enable_zonal_shift = true
+}
+
+resource "aws_cloudwatch_metric_alarm" "test" {
+  count = 2
+
+  alarm_name          = "%[1]s-${count.index}"
+  comparison_operator = "GreaterThanOrEqualToThreshold"
+  evaluation_periods  = 1
+  metric_name         = "UnHealthyHostCount"
+  namespace           = "AWS/NetworkELB"
+  period              = 60
+  statistic           = "Maximum"
+  threshold           = 1
+
+  dimensions = {
+    LoadBalancer = aws_lb.test.arn_suffix
+  }
+}
+`, rName))
+}
+
+func testAccPracticeRunConfigurationConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccPracticeRunConfigurationConfig_base(rName), `
+resource "aws_arczonalshift_practice_run_configuration" "test" {
+  resource_arn = aws_lb.test.arn
+
+  outcome_alarms {
+    alarm_identifier = aws_cloudwatch_metric_alarm.test[0].arn
+    type             = "CLOUDWATCH"
+  }
+}
+`)
+}
+
+func testAccPracticeRunConfigurationConfig_full(rName string) string {
+	return acctest.ConfigCompose(testAccPracticeRunConfigurationConfig_base(rName), `
+resource "aws_arczonalshift_practice_run_configuration" "test" {
+  resource_arn    = aws_lb.test.arn
+  blocked_dates   = ["2030-01-01"]
+  blocked_windows = ["Mon:00:00-Mon:08:00", "Sat:00:00-Sat:23:59"]
+
+  blocking_alarms {
+    alarm_identifier = aws_cloudwatch_metric_alarm.test[1].arn
+    type             = "CLOUDWATCH"
+  }
+
+  outcome_alarms {
+    alarm_identifier = aws_cloudwatch_metric_alarm.test[0].arn
+    type             = "CLOUDWATCH"
+  }
+}
+`)
+}
@@ -19,12 +19,50 @@ import (
 
 type servicePackage struct{}
 
+func (p *servicePackage) Actions(ctx context.Context) []*inttypes.ServicePackageAction {
+	return []*inttypes.ServicePackageAction{
+		{
+			Factory:  newCancelZonalShiftAction,
+			TypeName: "aws_arczonalshift_cancel_zonal_shift",
+			Name:     "Cancel Zonal Shift",
+			Region:   inttypes.ResourceRegionDefault(),
+		},
+		{
+			Factory:  newStartZonalShiftAction,
+			TypeName: "aws_arczonalshift_start_zonal_shift",
+			Name:     "Start Zonal Shift",
+			Region:   inttypes.ResourceRegionDefault(),
+		},
+	}
+}
+
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.ServicePackageFrameworkDataSource {
 	return []*inttypes.ServicePackageFrameworkDataSource{}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.ServicePackageFrameworkResource {
-	return []*inttypes.ServicePackageFrameworkResource{}
+	return []*inttypes.ServicePackageFrameworkResource{
+		{
+			Factory:  newAutoshiftObserverNotificationResource,
+			TypeName: "aws_arczonalshift_autoshift_observer_notification",
+			Name:     "Autoshift Observer Notification",
+			Region:   inttypes.ResourceRegionDefault(),
+			Identity: inttypes.RegionalSingletonIdentity(inttypes.WithIdentityDuplicateAttrs(names.AttrID)),
+			Import: inttypes.FrameworkImport{
+				WrappedImport: true,
+			},
+		},
+		{
+			Factory:  newPracticeRunConfigurationResource,
+			TypeName: "aws_arczonalshift_practice_run_configuration",
+			Name:     "Practice Run Configuration",
+			Region:   inttypes.ResourceRegionDefault(),
+			Identity: inttypes.RegionalARNIdentityNamed(names.AttrResourceARN),
+			Import: inttypes.FrameworkImport{
+				WrappedImport: true,
+			},
+		},
+	}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*inttypes.ServicePackageSDKDataSource {
@@ -0,0 +1,159 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package arczonalshift
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/arczonalshift"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/arczonalshift/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_arczonalshift_start_zonal_shift, name="Start Zonal Shift")
+func newStartZonalShiftAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &startZonalShiftAction{}, nil
+}
+
+var (
+	_ action.Action = (*startZonalShiftAction)(nil)
+)
+
+type startZonalShiftAction struct {
+	framework.ActionWithModel[startZonalShiftActionModel]
+}
+
+type startZonalShiftActionModel struct {
+	framework.WithRegionModel
+	AwayFrom    types.String `tfsdk:"away_from"`
+	Comment     types.String `tfsdk:"comment"`
+	ExpiresIn   types.String `tfsdk:"expires_in"`
+	ResourceARN fwtypes.ARN  `tfsdk:"resource_arn"`
+	Timeout     types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *startZonalShiftAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts an ARC zonal shift to move traffic for a managed resource away from an Availability Zone. This action will start the zonal shift and wait for it to be applied to the resource.",
+		Attributes: map[string]schema.Attribute{
+			"away_from": schema.StringAttribute{
+				Description: "The Availability Zone ID (for example, use1-az1) that traffic is moved away from",
+				Required:    true,
+			},
+			names.AttrComment: schema.StringAttribute{
+				Description: "A comment about the zonal shift",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(128),
+				},
+			},
+			"expires_in": schema.StringAttribute{
+				Description: "How long the zonal shift is active, as a whole number followed by m (minutes) or h (hours), for example 30m or 2h. The maximum is 72 hours",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexache.MustCompile(`^[1-9][0-9]*[mh]$`),
+						"must be a whole number followed by 'm' or 'h' (e.g., 30m or 2h)",
+					),
+				},
+			},
+			names.AttrResourceARN: schema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Description: "The ARN of the managed resource to shift traffic for",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the zonal shift to be applied (default: 300)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(30),
+					int64validator.AtMost(3600),
+				},
+			},
+		},
+	}
+}
+
+func (a *startZonalShiftAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startZonalShiftActionModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get AWS client
+	conn := a.Meta().ARCZonalShiftClient(ctx)
+
+	resourceARN := fwflex.StringValueFromFramework(ctx, config.ResourceARN)
+	awayFrom := fwflex.StringValueFromFramework(ctx, config.AwayFrom)
+
+	// Set default timeout if not provided
+	timeout := fwactions.TimeoutOr(config.Timeout, 300*time.Second)
+
+	tflog.Info(ctx, "Starting ARC start zonal shift action", map[string]any{
+		names.AttrResourceARN: resourceARN,
+		"away_from":           awayFrom,
+		"expires_in":          config.ExpiresIn.ValueString(),
+		names.AttrTimeout:     timeout.String(),
+	})
+
+	// Send initial progress update
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Starting zonal shift for %s away from %s...", resourceARN, awayFrom)
+
+	input := arczonalshift.StartZonalShiftInput{
+		AwayFrom:           aws.String(awayFrom),
+		Comment:            fwflex.StringFromFramework(ctx, config.Comment),
+		ExpiresIn:          fwflex.StringFromFramework(ctx, config.ExpiresIn),
+		ResourceIdentifier: aws.String(resourceARN),
+	}
+
+	output, err := conn.StartZonalShift(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Start Zonal Shift",
+			fmt.Sprintf("Could not start zonal shift for %s: %s", resourceARN, err),
+		)
+		return
+	}
+
+	zonalShiftID := aws.ToString(output.ZonalShiftId)
+
+	cb(ctx, "Zonal shift %s started (expires %s), waiting for it to be applied...", zonalShiftID, aws.ToTime(output.ExpiryTime).Format(time.RFC3339))
+
+	err = waitZonalShiftActionStatus(ctx, conn, resourceARN, zonalShiftID, timeout, cb,
+		string(awstypes.AppliedStatusApplied),
+		string(awstypes.AppliedStatusNotApplied),
+		zonalShiftStatusNotFound,
+	)
+	if err != nil {
+		addZonalShiftActionWaitError(&resp.Diagnostics, err, zonalShiftID, "Apply", timeout)
+		return
+	}
+
+	// Final success message
+	cb(ctx, "Zonal shift %s has been applied, traffic for %s is shifted away from %s", zonalShiftID, resourceARN, awayFrom)
+
+	tflog.Info(ctx, "ARC start zonal shift action completed successfully", map[string]any{
+		names.AttrResourceARN: resourceARN,
+		"zonal_shift_id":      zonalShiftID,
+	})
+}
@@ -0,0 +1,132 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package arczonalshift_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfarczonalshift "github.com/hashicorp/terraform-provider-aws/internal/service/arczonalshift"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccARCZonalShiftStartZonalShiftAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_lb.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ARCZonalShiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartZonalShiftActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckActiveZonalShiftCount(ctx, t, resourceName, 1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccARCZonalShiftStartZonalShiftAction_invalidExpiresIn(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ARCZonalShiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStartZonalShiftActionConfig_expiresIn(rName, "30s"),
+				ExpectError: regexache.MustCompile(`must be a whole number followed by 'm' or 'h'`),
+			},
+		},
+	})
+}
+
+func testAccCheckActiveZonalShiftCount(ctx context.Context, t *testing.T, n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).ARCZonalShiftClient(ctx)
+
+		output, err := tfarczonalshift.FindActiveZonalShiftsByResourceARN(ctx, conn, rs.Primary.Attributes[names.AttrARN])
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(output); got != want {
+			return fmt.Errorf("Expected %d active ARC zonal shifts for %s, got %d", want, rs.Primary.Attributes[names.AttrARN], got)
+		}
+
+		return nil
+	}
+}
+
+func testAccZonalShiftActionConfig_base(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
+resource "aws_lb" "test" {
+  name               = %[1]q
+  internal           = true
+  load_balancer_type = "network"
+  subnets            = aws_subnet.test[*].id
+  enable_zonal_shift = true
+}
+`, rName))
+}
+
+func testAccStartZonalShiftActionConfig_basic(rName string) string {
+	return testAccStartZonalShiftActionConfig_expiresIn(rName, "10m")
+}
+
+func testAccStartZonalShiftActionConfig_expiresIn(rName, expiresIn string) string {
+	return acctest.ConfigCompose(testAccZonalShiftActionConfig_base(rName), fmt.Sprintf(`
+action "aws_arczonalshift_start_zonal_shift" "test" {
+  config {
+    resource_arn = aws_lb.test.arn
+    away_from    = aws_subnet.test[0].availability_zone_id
+    expires_in   = %[1]q
+    comment      = "Terraform acceptance test"
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_arczonalshift_start_zonal_shift.test]
+    }
+  }
+
+  depends_on = [aws_lb.test]
+}
+`, expiresIn))
+}
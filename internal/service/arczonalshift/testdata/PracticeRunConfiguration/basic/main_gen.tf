@@ -0,0 +1,72 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_arczonalshift_practice_run_configuration" "test" {
+  resource_arn = aws_lb.test.arn
+
+  outcome_alarms {
+    alarm_identifier = aws_cloudwatch_metric_alarm.test.arn
+    type             = "CLOUDWATCH"
+  }
+}
+
+resource "aws_lb" "test" {
+  name               = var.rName
+  internal           = true
+  load_balancer_type = "network"
+  subnets            = aws_subnet.test[*].id
+  enable_zonal_shift = true
+}
+
+resource "aws_cloudwatch_metric_alarm" "test" {
+  alarm_name          = var.rName
+  comparison_operator = "GreaterThanOrEqualToThreshold"
+  evaluation_periods  = 1
+  metric_name         = "UnHealthyHostCount"
+  namespace           = "AWS/NetworkELB"
+  period              = 60
+  statistic           = "Maximum"
+  threshold           = 1
+
+  dimensions = {
+    LoadBalancer = aws_lb.test.arn_suffix
+  }
+}
+
+# acctest.ConfigVPCWithSubnets(rName, 2)
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+}
+
+# acctest.ConfigSubnets(rName, 2)
+
+resource "aws_subnet" "test" {
+  count = 2
+
+  vpc_id            = aws_vpc.test.id
+  availability_zone = data.aws_availability_zones.available.names[count.index]
+  cidr_block        = cidrsubnet(aws_vpc.test.cidr_block, 8, count.index)
+}
+
+# acctest.ConfigAvailableAZsNoOptInDefaultExclude
+
+data "aws_availability_zones" "available" {
+  exclude_zone_ids = local.default_exclude_zone_ids
+  state            = "available"
+
+  filter {
+    name   = "opt-in-status"
+    values = ["opt-in-not-required"]
+  }
+}
+
+locals {
+  default_exclude_zone_ids = ["usw2-az4", "usgw1-az2"]
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
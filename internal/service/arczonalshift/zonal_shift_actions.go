@@ -0,0 +1,126 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package arczonalshift
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/arczonalshift"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/arczonalshift/types"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+)
+
+// zonalShiftActionPollInterval defines polling cadence for the zonal shift actions.
+const zonalShiftActionPollInterval = 10 * time.Second
+
+// zonalShiftStatusNotFound is reported while a zonal shift is not listed against its managed resource.
+const zonalShiftStatusNotFound = "NOT_FOUND"
+
+func findZonalShifts(ctx context.Context, conn *arczonalshift.Client, input *arczonalshift.ListZonalShiftsInput, filter tfslices.Predicate[*awstypes.ZonalShiftSummary]) ([]awstypes.ZonalShiftSummary, error) {
+	var output []awstypes.ZonalShiftSummary
+
+	pages := arczonalshift.NewListZonalShiftsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Items {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+func findActiveZonalShiftsByResourceARN(ctx context.Context, conn *arczonalshift.Client, resourceARN string) ([]awstypes.ZonalShiftSummary, error) {
+	input := arczonalshift.ListZonalShiftsInput{
+		ResourceIdentifier: aws.String(resourceARN),
+		Status:             awstypes.ZonalShiftStatusActive,
+	}
+
+	return findZonalShifts(ctx, conn, &input, func(v *awstypes.ZonalShiftSummary) bool {
+		return v.ShiftType == "" || v.ShiftType == awstypes.ShiftTypeZonalShift
+	})
+}
+
+func findZonalShiftInResourceByTwoPartKey(ctx context.Context, conn *arczonalshift.Client, resourceARN, zonalShiftID string) (*awstypes.ZonalShiftInResource, error) {
+	output, err := findManagedResourceByARN(ctx, conn, resourceARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range output.ZonalShifts {
+		if aws.ToString(v.ZonalShiftId) == zonalShiftID {
+			return &v, nil
+		}
+	}
+
+	return nil, &retry.NotFoundError{}
+}
+
+// waitZonalShiftActionStatus polls the managed resource until the zonal shift reaches the target status,
+// sending a progress update every polling interval.
+func waitZonalShiftActionStatus(ctx context.Context, conn *arczonalshift.Client, resourceARN, zonalShiftID string, timeout time.Duration, cb fwactions.SendProgressFunc, target string, transitional ...string) error {
+	transitionalStates := tfslices.ApplyToAll(transitional, func(v string) actionwait.Status {
+		return actionwait.Status(v)
+	})
+
+	_, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[struct{}], error) {
+		zonalShift, err := findZonalShiftInResourceByTwoPartKey(ctx, conn, resourceARN, zonalShiftID)
+		if retry.NotFound(err) {
+			return actionwait.FetchResult[struct{}]{Status: zonalShiftStatusNotFound}, nil
+		}
+		if err != nil {
+			return actionwait.FetchResult[struct{}]{}, fmt.Errorf("reading managed resource: %w", err)
+		}
+		return actionwait.FetchResult[struct{}]{Status: actionwait.Status(zonalShift.AppliedStatus)}, nil
+	}, actionwait.Options[struct{}]{
+		Timeout:            timeout,
+		Interval:           actionwait.FixedInterval(zonalShiftActionPollInterval),
+		ProgressInterval:   30 * time.Second,
+		SuccessStates:      []actionwait.Status{actionwait.Status(target)},
+		TransitionalStates: transitionalStates,
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			cb(ctx, "Zonal shift %s is currently '%s', continuing to wait for '%s'...", zonalShiftID, fr.Status, target)
+		},
+	})
+
+	return err
+}
+
+// addZonalShiftActionWaitError converts an actionwait error into a diagnostic.
+func addZonalShiftActionWaitError(diags *diag.Diagnostics, err error, zonalShiftID, operation string, timeout time.Duration) {
+	var timeoutErr *actionwait.TimeoutError
+	var unexpectedErr *actionwait.UnexpectedStateError
+	if errors.As(err, &timeoutErr) {
+		diags.AddError(
+			"Timeout Waiting for Zonal Shift to "+operation,
+			fmt.Sprintf("Zonal shift %s did not complete within %s: %s", zonalShiftID, timeout, err),
+		)
+	} else if errors.As(err, &unexpectedErr) {
+		diags.AddError(
+			"Unexpected Zonal Shift Status",
+			fmt.Sprintf("Zonal shift %s entered unexpected status: %s", zonalShiftID, err),
+		)
+	} else {
+		diags.AddError(
+			"Error Waiting for Zonal Shift to "+operation,
+			fmt.Sprintf("Error while waiting for zonal shift %s: %s", zonalShiftID, err),
+		)
+	}
+}
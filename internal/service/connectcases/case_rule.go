@@ -0,0 +1,836 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package connectcases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connectcases"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/connectcases/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_connectcases_case_rule", name="Case Rule")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("domain_id")
+// @IdentityAttribute("case_rule_id")
+// @ImportIDHandler("caseRuleImportID")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/connectcases/types;awstypes;awstypes.GetCaseRuleResponse")
+// @Testing(importStateIdAttributes="domain_id;case_rule_id", importStateIdAttributesSep="flex.ResourceIdSeparator")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(hasNoPreExistingResource=true)
+func newCaseRuleResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &caseRuleResource{}, nil
+}
+
+type caseRuleResource struct {
+	framework.ResourceWithModel[caseRuleResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *caseRuleResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	booleanOperandsBlock := func(ctx context.Context) schema.Block {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[booleanOperandsModel](ctx),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"result": schema.BoolAttribute{
+						Required: true,
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"operand_one": schema.ListNestedBlock{
+						CustomType: fwtypes.NewListNestedObjectTypeOf[operandOneModel](ctx),
+						Validators: []validator.List{
+							listvalidator.IsRequired(),
+							listvalidator.SizeAtMost(1),
+						},
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"field_id": schema.StringAttribute{
+									Required: true,
+								},
+							},
+						},
+					},
+					"operand_two": schema.ListNestedBlock{
+						CustomType: fwtypes.NewListNestedObjectTypeOf[operandTwoModel](ctx),
+						Validators: []validator.List{
+							listvalidator.IsRequired(),
+							listvalidator.SizeAtMost(1),
+						},
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"boolean_value": schema.BoolAttribute{
+									Optional: true,
+								},
+								"double_value": schema.Float64Attribute{
+									Optional: true,
+								},
+								"string_value": schema.StringAttribute{
+									Optional: true,
+								},
+							},
+							Blocks: map[string]schema.Block{
+								"empty_value": schema.ListNestedBlock{
+									CustomType: fwtypes.NewListNestedObjectTypeOf[emptyOperandValueModel](ctx),
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(1),
+									},
+									NestedObject: schema.NestedBlockObject{},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	conditionBlock := func(ctx context.Context) schema.Block {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[caseRuleConditionModel](ctx),
+			Validators: []validator.List{
+				listvalidator.IsRequired(),
+				listvalidator.SizeAtLeast(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Blocks: map[string]schema.Block{
+					"and_all":      compoundConditionBlock(ctx, booleanOperandsBlock),
+					"equal_to":     booleanOperandsBlock(ctx),
+					"not_equal_to": booleanOperandsBlock(ctx),
+					"or_all":       compoundConditionBlock(ctx, booleanOperandsBlock),
+				},
+			},
+		}
+	}
+	conditionalRuleBlock := func(ctx context.Context) schema.Block {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[conditionalCaseRuleModel](ctx),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					names.AttrDefaultValue: schema.BoolAttribute{
+						Required: true,
+					},
+				},
+				Blocks: map[string]schema.Block{
+					names.AttrCondition: conditionBlock(ctx),
+				},
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"case_rule_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{Optional: true},
+			"domain_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrRule: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[caseRuleDetailsModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"field_options": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[fieldOptionsCaseRuleModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("field_options"),
+									path.MatchRelative().AtParent().AtName("hidden"),
+									path.MatchRelative().AtParent().AtName("required"),
+								),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"child_field_id": schema.StringAttribute{
+										Required: true,
+									},
+									"parent_field_id": schema.StringAttribute{
+										Required: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"parent_child_field_options_mapping": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[parentChildFieldOptionsMappingModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtLeast(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"child_field_option_values": schema.ListAttribute{
+													CustomType:  fwtypes.ListOfStringType,
+													ElementType: types.StringType,
+													Required:    true,
+												},
+												"parent_field_option_value": schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"hidden":   conditionalRuleBlock(ctx),
+						"required": conditionalRuleBlock(ctx),
+					},
+				},
+			},
+		},
+	}
+}
+
+func compoundConditionBlock(ctx context.Context, booleanOperandsBlock func(context.Context) schema.Block) schema.Block {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[compoundConditionModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				names.AttrCondition: schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[booleanConditionModel](ctx),
+					Validators: []validator.List{
+						listvalidator.IsRequired(),
+						listvalidator.SizeAtLeast(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Blocks: map[string]schema.Block{
+							"equal_to":     booleanOperandsBlock(ctx),
+							"not_equal_to": booleanOperandsBlock(ctx),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *caseRuleResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan caseRuleResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, plan.Name)
+	var input connectcases.CreateCaseRuleInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, plan, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateCaseRule(ctx, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	caseRuleID := aws.ToString(output.CaseRuleId)
+
+	// CreateCaseRule doesn't accept tags.
+	if err := createTags(ctx, conn, aws.ToString(output.CaseRuleArn), getTagsIn(ctx)); err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, caseRuleID)
+		return
+	}
+
+	// Set values for unknowns.
+	plan.ARN = fwflex.StringToFramework(ctx, output.CaseRuleArn)
+	plan.CaseRuleID = fwflex.StringValueToFramework(ctx, caseRuleID)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, plan))
+}
+
+func (r *caseRuleResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data caseRuleResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	domainID, caseRuleID := fwflex.StringValueFromFramework(ctx, data.DomainID), fwflex.StringValueFromFramework(ctx, data.CaseRuleID)
+	output, err := findCaseRuleByTwoPartKey(ctx, conn, domainID, caseRuleID)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, caseRuleID)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &data, fwflex.WithFieldNamePrefix("CaseRule")))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *caseRuleResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old caseRuleResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &new))
+	if response.Diagnostics.HasError() {
+		return
+	}
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &old))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	diff, d := fwflex.Diff(ctx, new, old)
+	smerr.AddEnrich(ctx, &response.Diagnostics, d)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		caseRuleID := fwflex.StringValueFromFramework(ctx, new.CaseRuleID)
+		var input connectcases.UpdateCaseRuleInput
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, new, &input))
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateCaseRule(ctx, &input)
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, caseRuleID)
+			return
+		}
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &new))
+}
+
+func (r *caseRuleResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data caseRuleResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	domainID, caseRuleID := fwflex.StringValueFromFramework(ctx, data.DomainID), fwflex.StringValueFromFramework(ctx, data.CaseRuleID)
+	input := connectcases.DeleteCaseRuleInput{
+		CaseRuleId: aws.String(caseRuleID),
+		DomainId:   aws.String(domainID),
+	}
+	_, err := conn.DeleteCaseRule(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, caseRuleID)
+		return
+	}
+}
+
+var (
+	_ inttypes.ImportIDParser = caseRuleImportID{}
+)
+
+type caseRuleImportID struct{}
+
+func (caseRuleImportID) Parse(id string) (string, map[string]any, error) {
+	domainID, caseRuleID, found := strings.Cut(id, intflex.ResourceIdSeparator)
+	if !found {
+		return "", nil, fmt.Errorf("id %q should be in the format <domain-id>%s<case-rule-id>", id, intflex.ResourceIdSeparator)
+	}
+
+	result := map[string]any{
+		"case_rule_id": caseRuleID,
+		"domain_id":    domainID,
+	}
+
+	return id, result, nil
+}
+
+func findCaseRuleByTwoPartKey(ctx context.Context, conn *connectcases.Client, domainID, caseRuleID string) (*awstypes.GetCaseRuleResponse, error) {
+	input := connectcases.BatchGetCaseRuleInput{
+		CaseRules: []awstypes.CaseRuleIdentifier{
+			{Id: aws.String(caseRuleID)},
+		},
+		DomainId: aws.String(domainID),
+	}
+	output, err := conn.BatchGetCaseRule(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError())
+	}
+
+	// A case rule that doesn't exist is reported as a per-item error.
+	for _, v := range output.Errors {
+		if aws.ToString(v.Id) == caseRuleID {
+			return nil, smarterr.NewError(&retry.NotFoundError{
+				LastError: errors.New(aws.ToString(v.Message)),
+			})
+		}
+	}
+
+	caseRule, err := tfresource.AssertSingleValueResult(output.CaseRules)
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if caseRule.Deleted {
+		return nil, smarterr.NewError(&retry.NotFoundError{})
+	}
+
+	return caseRule, nil
+}
+
+type caseRuleResourceModel struct {
+	framework.WithRegionModel
+	ARN         types.String                                          `tfsdk:"arn"`
+	CaseRuleID  types.String                                          `tfsdk:"case_rule_id"`
+	Description types.String                                          `tfsdk:"description"`
+	DomainID    types.String                                          `tfsdk:"domain_id"`
+	Name        types.String                                          `tfsdk:"name"`
+	Rule        fwtypes.ListNestedObjectValueOf[caseRuleDetailsModel] `tfsdk:"rule"`
+	Tags        tftags.Map                                            `tfsdk:"tags"`
+	TagsAll     tftags.Map                                            `tfsdk:"tags_all"`
+}
+
+type caseRuleDetailsModel struct {
+	FieldOptions fwtypes.ListNestedObjectValueOf[fieldOptionsCaseRuleModel] `tfsdk:"field_options"`
+	Hidden       fwtypes.ListNestedObjectValueOf[conditionalCaseRuleModel]  `tfsdk:"hidden"`
+	Required     fwtypes.ListNestedObjectValueOf[conditionalCaseRuleModel]  `tfsdk:"required"`
+}
+
+var (
+	_ fwflex.Expander  = caseRuleDetailsModel{}
+	_ fwflex.Flattener = &caseRuleDetailsModel{}
+)
+
+func (m *caseRuleDetailsModel) Flatten(ctx context.Context, v any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	switch t := v.(type) {
+	case awstypes.CaseRuleDetailsMemberFieldOptions:
+		var data fieldOptionsCaseRuleModel
+		smerr.AddEnrich(ctx, &diags, fwflex.Flatten(ctx, t.Value, &data))
+		if diags.HasError() {
+			return diags
+		}
+		m.FieldOptions = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+
+	case awstypes.CaseRuleDetailsMemberHidden:
+		var data conditionalCaseRuleModel
+		smerr.AddEnrich(ctx, &diags, fwflex.Flatten(ctx, t.Value, &data))
+		if diags.HasError() {
+			return diags
+		}
+		m.Hidden = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+
+	case awstypes.CaseRuleDetailsMemberRequired:
+		var data conditionalCaseRuleModel
+		smerr.AddEnrich(ctx, &diags, fwflex.Flatten(ctx, t.Value, &data))
+		if diags.HasError() {
+			return diags
+		}
+		m.Required = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+
+	default:
+		diags.AddError(
+			"Unsupported Type",
+			fmt.Sprintf("case rule details flatten: %T", v),
+		)
+	}
+	return diags
+}
+
+func (m caseRuleDetailsModel) Expand(ctx context.Context) (any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	switch {
+	case !m.FieldOptions.IsNull():
+		data, d := m.FieldOptions.ToPtr(ctx)
+		smerr.AddEnrich(ctx, &diags, d)
+		if diags.HasError() {
+			return nil, diags
+		}
+		var r awstypes.CaseRuleDetailsMemberFieldOptions
+		smerr.AddEnrich(ctx, &diags, fwflex.Expand(ctx, data, &r.Value))
+		if diags.HasError() {
+			return nil, diags
+		}
+		return &r, diags
+
+	case !m.Hidden.IsNull():
+		data, d := m.Hidden.ToPtr(ctx)
+		smerr.AddEnrich(ctx, &diags, d)
+		if diags.HasError() {
+			return nil, diags
+		}
+		var r awstypes.CaseRuleDetailsMemberHidden
+		smerr.AddEnrich(ctx, &diags, fwflex.Expand(ctx, data, &r.Value))
+		if diags.HasError() {
+			return nil, diags
+		}
+		return &r, diags
+
+	case !m.Required.IsNull():
+		data, d := m.Required.ToPtr(ctx)
+		smerr.AddEnrich(ctx, &diags, d)
+		if diags.HasError() {
+			return nil, diags
+		}
+		var r awstypes.CaseRuleDetailsMemberRequired
+		smerr.AddEnrich(ctx, &diags, fwflex.Expand(ctx, data, &r.Value))
+		if diags.HasError() {
+			return nil, diags
+		}
+		return &r, diags
+	}
+	return nil, diags
+}
+
+type fieldOptionsCaseRuleModel struct {
+	ChildFieldID                    types.String                                                         `tfsdk:"child_field_id"`
+	ParentChildFieldOptionsMappings fwtypes.ListNestedObjectValueOf[parentChildFieldOptionsMappingModel] `tfsdk:"parent_child_field_options_mapping"`
+	ParentFieldID                   types.String                                                         `tfsdk:"parent_field_id"`
+}
+
+type parentChildFieldOptionsMappingModel struct {
+	ChildFieldOptionValues fwtypes.ListOfString `tfsdk:"child_field_option_values"`
+	ParentFieldOptionValue types.String         `tfsdk:"parent_field_option_value"`
+}
+
+// conditionalCaseRuleModel represents both the Hidden and the Required case rule types.
+type conditionalCaseRuleModel struct {
+	Conditions   fwtypes.ListNestedObjectValueOf[caseRuleConditionModel] `tfsdk:"condition"`
+	DefaultValue types.Bool                                              `tfsdk:"default_value"`
+}
+
+type caseRuleConditionModel struct {
+	AndAll     fwtypes.ListNestedObjectValueOf[compoundConditionModel] `tfsdk:"and_all"`
+	EqualTo    fwtypes.ListNestedObjectValueOf[booleanOperandsModel]   `tfsdk:"equal_to"`
+	NotEqualTo fwtypes.ListNestedObjectValueOf[booleanOperandsModel]   `tfsdk:"not_equal_to"`
+	OrAll      fwtypes.ListNestedObjectValueOf[compoundConditionModel] `tfsdk:"or_all"`
+}
+
+var (
+	_ fwflex.Expander  = caseRuleConditionModel{}
+	_ fwflex.Flattener = &caseRuleConditionModel{}
+)
+
+func (m *caseRuleConditionModel) Flatten(ctx context.Context, v any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	switch t := v.(type) {
+	case awstypes.BooleanConditionMemberAndAll:
+		var data compoundConditionModel
+		smerr.AddEnrich(ctx, &diags, fwflex.Flatten(ctx, t.Value, &data))
+		if diags.HasError() {
+			return diags
+		}
+		m.AndAll = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+
+	case awstypes.BooleanConditionMemberOrAll:
+		var data compoundConditionModel
+		smerr.AddEnrich(ctx, &diags, fwflex.Flatten(ctx, t.Value, &data))
+		if diags.HasError() {
+			return diags
+		}
+		m.OrAll = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+
+	default:
+		var data booleanConditionModel
+		smerr.AddEnrich(ctx, &diags, data.Flatten(ctx, v))
+		if diags.HasError() {
+			return diags
+		}
+		m.EqualTo, m.NotEqualTo = data.EqualTo, data.NotEqualTo
+	}
+	return diags
+}
+
+func (m caseRuleConditionModel) Expand(ctx context.Context) (any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	switch {
+	case !m.AndAll.IsNull():
+		data, d := m.AndAll.ToPtr(ctx)
+		smerr.AddEnrich(ctx, &diags, d)
+		if diags.HasError() {
+			return nil, diags
+		}
+		var r awstypes.BooleanConditionMemberAndAll
+		smerr.AddEnrich(ctx, &diags, fwflex.Expand(ctx, data, &r.Value))
+		if diags.HasError() {
+			return nil, diags
+		}
+		return &r, diags
+
+	case !m.OrAll.IsNull():
+		data, d := m.OrAll.ToPtr(ctx)
+		smerr.AddEnrich(ctx, &diags, d)
+		if diags.HasError() {
+			return nil, diags
+		}
+		var r awstypes.BooleanConditionMemberOrAll
+		smerr.AddEnrich(ctx, &diags, fwflex.Expand(ctx, data, &r.Value))
+		if diags.HasError() {
+			return nil, diags
+		}
+		return &r, diags
+	}
+
+	return booleanConditionModel{
+		EqualTo:    m.EqualTo,
+		NotEqualTo: m.NotEqualTo,
+	}.Expand(ctx)
+}
+
+type compoundConditionModel struct {
+	Conditions fwtypes.ListNestedObjectValueOf[booleanConditionModel] `tfsdk:"condition"`
+}
+
+// booleanConditionModel is a condition nested in an `and_all` or `or_all` condition.
+// Compound conditions can't be nested further.
+type booleanConditionModel struct {
+	EqualTo    fwtypes.ListNestedObjectValueOf[booleanOperandsModel] `tfsdk:"equal_to"`
+	NotEqualTo fwtypes.ListNestedObjectValueOf[booleanOperandsModel] `tfsdk:"not_equal_to"`
+}
+
+var (
+	_ fwflex.Expander  = booleanConditionModel{}
+	_ fwflex.Flattener = &booleanConditionModel{}
+)
+
+func (m *booleanConditionModel) Flatten(ctx context.Context, v any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	switch t := v.(type) {
+	case awstypes.BooleanConditionMemberEqualTo:
+		var data booleanOperandsModel
+		smerr.AddEnrich(ctx, &diags, fwflex.Flatten(ctx, t.Value, &data))
+		if diags.HasError() {
+			return diags
+		}
+		m.EqualTo = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+
+	case awstypes.BooleanConditionMemberNotEqualTo:
+		var data booleanOperandsModel
+		smerr.AddEnrich(ctx, &diags, fwflex.Flatten(ctx, t.Value, &data))
+		if diags.HasError() {
+			return diags
+		}
+		m.NotEqualTo = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+
+	default:
+		diags.AddError(
+			"Unsupported Type",
+			fmt.Sprintf("boolean condition flatten: %T", v),
+		)
+	}
+	return diags
+}
+
+func (m booleanConditionModel) Expand(ctx context.Context) (any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	switch {
+	case !m.EqualTo.IsNull():
+		data, d := m.EqualTo.ToPtr(ctx)
+		smerr.AddEnrich(ctx, &diags, d)
+		if diags.HasError() {
+			return nil, diags
+		}
+		var r awstypes.BooleanConditionMemberEqualTo
+		smerr.AddEnrich(ctx, &diags, fwflex.Expand(ctx, data, &r.Value))
+		if diags.HasError() {
+			return nil, diags
+		}
+		return &r, diags
+
+	case !m.NotEqualTo.IsNull():
+		data, d := m.NotEqualTo.ToPtr(ctx)
+		smerr.AddEnrich(ctx, &diags, d)
+		if diags.HasError() {
+			return nil, diags
+		}
+		var r awstypes.BooleanConditionMemberNotEqualTo
+		smerr.AddEnrich(ctx, &diags, fwflex.Expand(ctx, data, &r.Value))
+		if diags.HasError() {
+			return nil, diags
+		}
+		return &r, diags
+	}
+	return nil, diags
+}
+
+type booleanOperandsModel struct {
+	OperandOne fwtypes.ListNestedObjectValueOf[operandOneModel] `tfsdk:"operand_one"`
+	OperandTwo fwtypes.ListNestedObjectValueOf[operandTwoModel] `tfsdk:"operand_two"`
+	Result     types.Bool                                       `tfsdk:"result"`
+}
+
+type operandOneModel struct {
+	FieldID types.String `tfsdk:"field_id"`
+}
+
+var (
+	_ fwflex.Expander  = operandOneModel{}
+	_ fwflex.Flattener = &operandOneModel{}
+)
+
+func (m *operandOneModel) Flatten(ctx context.Context, v any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	switch t := v.(type) {
+	case awstypes.OperandOneMemberFieldId:
+		m.FieldID = fwflex.StringValueToFramework(ctx, t.Value)
+
+	default:
+		diags.AddError(
+			"Unsupported Type",
+			fmt.Sprintf("operand one flatten: %T", v),
+		)
+	}
+	return diags
+}
+
+func (m operandOneModel) Expand(ctx context.Context) (any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	switch {
+	case !m.FieldID.IsNull():
+		return &awstypes.OperandOneMemberFieldId{
+			Value: fwflex.StringValueFromFramework(ctx, m.FieldID),
+		}, diags
+	}
+	return nil, diags
+}
+
+type operandTwoModel struct {
+	BooleanValue types.Bool                                              `tfsdk:"boolean_value"`
+	DoubleValue  types.Float64                                           `tfsdk:"double_value"`
+	EmptyValue   fwtypes.ListNestedObjectValueOf[emptyOperandValueModel] `tfsdk:"empty_value"`
+	StringValue  types.String                                            `tfsdk:"string_value"`
+}
+
+var (
+	_ fwflex.Expander  = operandTwoModel{}
+	_ fwflex.Flattener = &operandTwoModel{}
+)
+
+func (m *operandTwoModel) Flatten(ctx context.Context, v any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	switch t := v.(type) {
+	case awstypes.OperandTwoMemberBooleanValue:
+		m.BooleanValue = types.BoolValue(t.Value)
+
+	case awstypes.OperandTwoMemberDoubleValue:
+		m.DoubleValue = types.Float64Value(t.Value)
+
+	case awstypes.OperandTwoMemberEmptyValue:
+		m.EmptyValue = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &emptyOperandValueModel{})
+
+	case awstypes.OperandTwoMemberStringValue:
+		m.StringValue = fwflex.StringValueToFramework(ctx, t.Value)
+
+	default:
+		diags.AddError(
+			"Unsupported Type",
+			fmt.Sprintf("operand two flatten: %T", v),
+		)
+	}
+	return diags
+}
+
+func (m operandTwoModel) Expand(ctx context.Context) (any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	switch {
+	case !m.BooleanValue.IsNull():
+		return &awstypes.OperandTwoMemberBooleanValue{
+			Value: m.BooleanValue.ValueBool(),
+		}, diags
+
+	case !m.DoubleValue.IsNull():
+		return &awstypes.OperandTwoMemberDoubleValue{
+			Value: m.DoubleValue.ValueFloat64(),
+		}, diags
+
+	case !m.EmptyValue.IsNull():
+		return &awstypes.OperandTwoMemberEmptyValue{}, diags
+
+	case !m.StringValue.IsNull():
+		return &awstypes.OperandTwoMemberStringValue{
+			Value: fwflex.StringValueFromFramework(ctx, m.StringValue),
+		}, diags
+	}
+	return nil, diags
+}
+
+type emptyOperandValueModel struct{}
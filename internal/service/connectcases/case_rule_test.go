@@ -0,0 +1,250 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package connectcases_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/connectcases/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfknownvalue "github.com/hashicorp/terraform-provider-aws/internal/acctest/knownvalue"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfconnectcases "github.com/hashicorp/terraform-provider-aws/internal/service/connectcases"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccConnectCasesCaseRule_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.GetCaseRuleResponse
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_connectcases_case_rule.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectCasesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCaseRuleDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCaseRuleConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCaseRuleExists(ctx, t, resourceName, &v),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrARN), tfknownvalue.RegionalARNRegexp("cases", regexache.MustCompile(`domain/.+/case-rule/.+$`))),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("case_rule_id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrName), knownvalue.StringExact(rName)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRule).AtSliceIndex(0).AtMapKey("hidden"), knownvalue.ListSizeExact(0)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRule).AtSliceIndex(0).AtMapKey("required").AtSliceIndex(0).AtMapKey("default_value"), knownvalue.Bool(false)),
+				},
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateIdFunc:                    acctest.AttrsImportStateIdFunc(resourceName, ",", "domain_id", "case_rule_id"),
+				ImportStateVerifyIdentifierAttribute: "case_rule_id",
+			},
+			{
+				Config: testAccCaseRuleConfig_hidden(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCaseRuleExists(ctx, t, resourceName, &v),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrDescription), knownvalue.StringExact(rName)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRule).AtSliceIndex(0).AtMapKey("hidden").AtSliceIndex(0).AtMapKey(names.AttrCondition).AtSliceIndex(0).AtMapKey("and_all").AtSliceIndex(0).AtMapKey(names.AttrCondition), knownvalue.ListSizeExact(2)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRule).AtSliceIndex(0).AtMapKey("required"), knownvalue.ListSizeExact(0)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccConnectCasesCaseRule_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.GetCaseRuleResponse
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_connectcases_case_rule.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectCasesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCaseRuleDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCaseRuleConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCaseRuleExists(ctx, t, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, t, tfconnectcases.ResourceCaseRule, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckCaseRuleDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).ConnectCasesClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_connectcases_case_rule" {
+				continue
+			}
+
+			_, err := tfconnectcases.FindCaseRuleByTwoPartKey(ctx, conn, rs.Primary.Attributes["domain_id"], rs.Primary.Attributes["case_rule_id"])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Connect Cases Case Rule %s still exists", rs.Primary.Attributes["case_rule_id"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCaseRuleExists(ctx context.Context, t *testing.T, n string, v *awstypes.GetCaseRuleResponse) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).ConnectCasesClient(ctx)
+
+		output, err := tfconnectcases.FindCaseRuleByTwoPartKey(ctx, conn, rs.Primary.Attributes["domain_id"], rs.Primary.Attributes["case_rule_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCaseRuleConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccDomainConfig_basic(rName), fmt.Sprintf(`
+resource "aws_connectcases_field" "test" {
+  domain_id = aws_connectcases_domain.test.domain_id
+  name      = %[1]q
+  type      = "Boolean"
+}
+`, rName))
+}
+
+func testAccCaseRuleConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccCaseRuleConfig_base(rName), fmt.Sprintf(`
+resource "aws_connectcases_case_rule" "test" {
+  domain_id = aws_connectcases_domain.test.domain_id
+  name      = %[1]q
+
+  rule {
+    required {
+      default_value = false
+
+      condition {
+        equal_to {
+          result = true
+
+          operand_one {
+            field_id = aws_connectcases_field.test.field_id
+          }
+
+          operand_two {
+            boolean_value = true
+          }
+        }
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccCaseRuleConfig_hidden(rName string) string {
+	return acctest.ConfigCompose(testAccCaseRuleConfig_base(rName), fmt.Sprintf(`
+resource "aws_connectcases_case_rule" "test" {
+  domain_id   = aws_connectcases_domain.test.domain_id
+  name        = %[1]q
+  description = %[1]q
+
+  rule {
+    hidden {
+      default_value = true
+
+      condition {
+        and_all {
+          condition {
+            equal_to {
+              result = false
+
+              operand_one {
+                field_id = aws_connectcases_field.test.field_id
+              }
+
+              operand_two {
+                boolean_value = true
+              }
+            }
+          }
+
+          condition {
+            not_equal_to {
+              result = false
+
+              operand_one {
+                field_id = aws_connectcases_field.test.field_id
+              }
+
+              operand_two {
+                empty_value {}
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`, rName))
+}
@@ -0,0 +1,278 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package connectcases
+
+import (
+	"context"
+	"time"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connectcases"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/connectcases/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_connectcases_domain", name="Domain")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("domain_id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/connectcases;connectcases;connectcases.GetDomainOutput")
+// @Testing(importStateIdAttribute="domain_id")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(hasNoPreExistingResource=true)
+func newDomainResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &domainResource{}
+
+	r.SetDefaultCreateTimeout(10 * time.Minute)
+	r.SetDefaultDeleteTimeout(10 * time.Minute)
+
+	return r, nil
+}
+
+type domainResource struct {
+	framework.ResourceWithModel[domainResourceModel]
+	framework.WithTimeouts
+	framework.WithImportByIdentity
+}
+
+func (r *domainResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"domain_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.DomainStatus](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *domainResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan domainResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, plan.Name)
+	input := connectcases.CreateDomainInput{
+		Name: aws.String(name),
+	}
+	output, err := conn.CreateDomain(ctx, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	id := aws.ToString(output.DomainId)
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.SetAttribute(ctx, path.Root("domain_id"), id))
+
+	// CreateDomain doesn't accept tags.
+	if err := createTags(ctx, conn, aws.ToString(output.DomainArn), getTagsIn(ctx)); err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	domain, err := waitDomainCreated(ctx, conn, id, r.CreateTimeout(ctx, plan.Timeouts))
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, domain, &plan, fwflex.WithFieldNamePrefix("Domain")))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, plan))
+}
+
+func (r *domainResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data domainResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.DomainID)
+	output, err := findDomainByID(ctx, conn, id)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &data, fwflex.WithFieldNamePrefix("Domain")))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *domainResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data domainResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.DomainID)
+	input := connectcases.DeleteDomainInput{
+		DomainId: aws.String(id),
+	}
+	_, err := conn.DeleteDomain(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	if _, err := waitDomainDeleted(ctx, conn, id, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+}
+
+func findDomainByID(ctx context.Context, conn *connectcases.Client, id string) (*connectcases.GetDomainOutput, error) {
+	input := connectcases.GetDomainInput{
+		DomainId: aws.String(id),
+	}
+	output, err := conn.GetDomain(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError())
+	}
+
+	return output, nil
+}
+
+func statusDomain(conn *connectcases.Client, id string) retry.StateRefreshFunc {
+	return func(ctx context.Context) (any, string, error) {
+		output, err := findDomainByID(ctx, conn, id)
+
+		if retry.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.DomainStatus), nil
+	}
+}
+
+func waitDomainCreated(ctx context.Context, conn *connectcases.Client, id string, timeout time.Duration) (*connectcases.GetDomainOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.DomainStatusCreationInProgress),
+		Target:  enum.Slice(awstypes.DomainStatusActive),
+		Refresh: statusDomain(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*connectcases.GetDomainOutput); ok {
+		return output, smarterr.NewError(err)
+	}
+
+	return nil, smarterr.NewError(err)
+}
+
+func waitDomainDeleted(ctx context.Context, conn *connectcases.Client, id string, timeout time.Duration) (*connectcases.GetDomainOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.DomainStatusActive, awstypes.DomainStatusCreationFailed),
+		Target:  []string{},
+		Refresh: statusDomain(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*connectcases.GetDomainOutput); ok {
+		return output, smarterr.NewError(err)
+	}
+
+	return nil, smarterr.NewError(err)
+}
+
+type domainResourceModel struct {
+	framework.WithRegionModel
+	ARN          types.String                              `tfsdk:"arn"`
+	DomainID     types.String                              `tfsdk:"domain_id"`
+	DomainStatus fwtypes.StringEnum[awstypes.DomainStatus] `tfsdk:"domain_status"`
+	Name         types.String                              `tfsdk:"name"`
+	Tags         tftags.Map                                `tfsdk:"tags"`
+	TagsAll      tftags.Map                                `tfsdk:"tags_all"`
+	Timeouts     timeouts.Value                            `tfsdk:"timeouts"`
+}
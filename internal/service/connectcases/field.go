@@ -0,0 +1,392 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package connectcases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connectcases"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/connectcases/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_connectcases_field", name="Field")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("domain_id")
+// @IdentityAttribute("field_id")
+// @ImportIDHandler("fieldImportID")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/connectcases/types;awstypes;awstypes.GetFieldResponse")
+// @Testing(importStateIdAttributes="domain_id;field_id", importStateIdAttributesSep="flex.ResourceIdSeparator")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(hasNoPreExistingResource=true)
+func newFieldResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &fieldResource{}, nil
+}
+
+type fieldResource struct {
+	framework.ResourceWithModel[fieldResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *fieldResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN:         framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{Optional: true},
+			"domain_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"field_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrNamespace: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.FieldNamespace](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.FieldType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrAttributes: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[fieldAttributesModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"text": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[textAttributesModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"is_multiline": schema.BoolAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *fieldResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan fieldResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, plan.Name)
+	var input connectcases.CreateFieldInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, plan, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateField(ctx, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	domainID, fieldID := fwflex.StringValueFromFramework(ctx, plan.DomainID), aws.ToString(output.FieldId)
+
+	// CreateField doesn't accept tags.
+	if err := createTags(ctx, conn, aws.ToString(output.FieldArn), getTagsIn(ctx)); err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, fieldID)
+		return
+	}
+
+	field, err := findFieldByTwoPartKey(ctx, conn, domainID, fieldID)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, fieldID)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, field, &plan, fwflex.WithFieldNamePrefix("Field")))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, plan))
+}
+
+func (r *fieldResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data fieldResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	domainID, fieldID := fwflex.StringValueFromFramework(ctx, data.DomainID), fwflex.StringValueFromFramework(ctx, data.FieldID)
+	output, err := findFieldByTwoPartKey(ctx, conn, domainID, fieldID)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, fieldID)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &data, fwflex.WithFieldNamePrefix("Field")))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *fieldResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old fieldResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &new))
+	if response.Diagnostics.HasError() {
+		return
+	}
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &old))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	diff, d := fwflex.Diff(ctx, new, old)
+	smerr.AddEnrich(ctx, &response.Diagnostics, d)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		fieldID := fwflex.StringValueFromFramework(ctx, new.FieldID)
+		var input connectcases.UpdateFieldInput
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, new, &input))
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateField(ctx, &input)
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, fieldID)
+			return
+		}
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &new))
+}
+
+func (r *fieldResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data fieldResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	domainID, fieldID := fwflex.StringValueFromFramework(ctx, data.DomainID), fwflex.StringValueFromFramework(ctx, data.FieldID)
+	input := connectcases.DeleteFieldInput{
+		DomainId: aws.String(domainID),
+		FieldId:  aws.String(fieldID),
+	}
+	_, err := conn.DeleteField(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, fieldID)
+		return
+	}
+}
+
+var (
+	_ inttypes.ImportIDParser = fieldImportID{}
+)
+
+type fieldImportID struct{}
+
+func (fieldImportID) Parse(id string) (string, map[string]any, error) {
+	domainID, fieldID, found := strings.Cut(id, intflex.ResourceIdSeparator)
+	if !found {
+		return "", nil, fmt.Errorf("id %q should be in the format <domain-id>%s<field-id>", id, intflex.ResourceIdSeparator)
+	}
+
+	result := map[string]any{
+		"domain_id": domainID,
+		"field_id":  fieldID,
+	}
+
+	return id, result, nil
+}
+
+func findFieldByTwoPartKey(ctx context.Context, conn *connectcases.Client, domainID, fieldID string) (*awstypes.GetFieldResponse, error) {
+	input := connectcases.BatchGetFieldInput{
+		DomainId: aws.String(domainID),
+		Fields: []awstypes.FieldIdentifier{
+			{Id: aws.String(fieldID)},
+		},
+	}
+	output, err := conn.BatchGetField(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError())
+	}
+
+	// A field that doesn't exist is reported as a per-item error.
+	for _, v := range output.Errors {
+		if aws.ToString(v.Id) == fieldID {
+			return nil, smarterr.NewError(&retry.NotFoundError{
+				LastError: errors.New(aws.ToString(v.Message)),
+			})
+		}
+	}
+
+	field, err := tfresource.AssertSingleValueResult(output.Fields)
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if field.Deleted {
+		return nil, smarterr.NewError(&retry.NotFoundError{})
+	}
+
+	return field, nil
+}
+
+type fieldResourceModel struct {
+	framework.WithRegionModel
+	ARN         types.String                                          `tfsdk:"arn"`
+	Attributes  fwtypes.ListNestedObjectValueOf[fieldAttributesModel] `tfsdk:"attributes"`
+	Description types.String                                          `tfsdk:"description"`
+	DomainID    types.String                                          `tfsdk:"domain_id"`
+	FieldID     types.String                                          `tfsdk:"field_id"`
+	Name        types.String                                          `tfsdk:"name"`
+	Namespace   fwtypes.StringEnum[awstypes.FieldNamespace]           `tfsdk:"namespace"`
+	Tags        tftags.Map                                            `tfsdk:"tags"`
+	TagsAll     tftags.Map                                            `tfsdk:"tags_all"`
+	Type        fwtypes.StringEnum[awstypes.FieldType]                `tfsdk:"type"`
+}
+
+type fieldAttributesModel struct {
+	Text fwtypes.ListNestedObjectValueOf[textAttributesModel] `tfsdk:"text"`
+}
+
+var (
+	_ fwflex.Expander  = fieldAttributesModel{}
+	_ fwflex.Flattener = &fieldAttributesModel{}
+)
+
+func (m *fieldAttributesModel) Flatten(ctx context.Context, v any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	switch t := v.(type) {
+	case awstypes.FieldAttributesMemberText:
+		var data textAttributesModel
+		smerr.AddEnrich(ctx, &diags, fwflex.Flatten(ctx, t.Value, &data))
+		if diags.HasError() {
+			return diags
+		}
+		m.Text = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+
+	default:
+		diags.AddError(
+			"Unsupported Type",
+			fmt.Sprintf("field attributes flatten: %T", v),
+		)
+	}
+	return diags
+}
+
+func (m fieldAttributesModel) Expand(ctx context.Context) (any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	switch {
+	case !m.Text.IsNull():
+		data, d := m.Text.ToPtr(ctx)
+		smerr.AddEnrich(ctx, &diags, d)
+		if diags.HasError() {
+			return nil, diags
+		}
+		var r awstypes.FieldAttributesMemberText
+		smerr.AddEnrich(ctx, &diags, fwflex.Expand(ctx, data, &r.Value))
+		if diags.HasError() {
+			return nil, diags
+		}
+		return &r, diags
+	}
+	return nil, diags
+}
+
+type textAttributesModel struct {
+	IsMultiline types.Bool `tfsdk:"is_multiline"`
+}
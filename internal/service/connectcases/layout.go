@@ -0,0 +1,460 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package connectcases
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connectcases"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/connectcases/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_connectcases_layout", name="Layout")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("domain_id")
+// @IdentityAttribute("layout_id")
+// @ImportIDHandler("layoutImportID")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/connectcases;connectcases;connectcases.GetLayoutOutput")
+// @Testing(importStateIdAttributes="domain_id;layout_id", importStateIdAttributesSep="flex.ResourceIdSeparator")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(hasNoPreExistingResource=true)
+func newLayoutResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &layoutResource{}, nil
+}
+
+type layoutResource struct {
+	framework.ResourceWithModel[layoutResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *layoutResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	layoutSectionsBlock := func(ctx context.Context) schema.Block {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[layoutSectionsModel](ctx),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Blocks: map[string]schema.Block{
+					"section": schema.ListNestedBlock{
+						CustomType: fwtypes.NewListNestedObjectTypeOf[sectionModel](ctx),
+						NestedObject: schema.NestedBlockObject{
+							Blocks: map[string]schema.Block{
+								"field_group": schema.ListNestedBlock{
+									CustomType: fwtypes.NewListNestedObjectTypeOf[fieldGroupModel](ctx),
+									Validators: []validator.List{
+										listvalidator.IsRequired(),
+										listvalidator.SizeAtMost(1),
+									},
+									NestedObject: schema.NestedBlockObject{
+										Attributes: map[string]schema.Attribute{
+											names.AttrName: schema.StringAttribute{
+												Optional: true,
+											},
+										},
+										Blocks: map[string]schema.Block{
+											names.AttrField: schema.ListNestedBlock{
+												CustomType: fwtypes.NewListNestedObjectTypeOf[fieldItemModel](ctx),
+												Validators: []validator.List{
+													listvalidator.IsRequired(),
+													listvalidator.SizeAtLeast(1),
+												},
+												NestedObject: schema.NestedBlockObject{
+													Attributes: map[string]schema.Attribute{
+														names.AttrID: schema.StringAttribute{
+															Required: true,
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"domain_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"layout_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrContent: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[layoutContentModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"basic": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[basicLayoutModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"more_info": layoutSectionsBlock(ctx),
+									"top_panel": layoutSectionsBlock(ctx),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *layoutResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan layoutResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, plan.Name)
+	var input connectcases.CreateLayoutInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, plan, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateLayout(ctx, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	layoutID := aws.ToString(output.LayoutId)
+
+	// CreateLayout doesn't accept tags.
+	if err := createTags(ctx, conn, aws.ToString(output.LayoutArn), getTagsIn(ctx)); err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, layoutID)
+		return
+	}
+
+	// Set values for unknowns.
+	plan.ARN = fwflex.StringToFramework(ctx, output.LayoutArn)
+	plan.LayoutID = fwflex.StringValueToFramework(ctx, layoutID)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, plan))
+}
+
+func (r *layoutResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data layoutResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	domainID, layoutID := fwflex.StringValueFromFramework(ctx, data.DomainID), fwflex.StringValueFromFramework(ctx, data.LayoutID)
+	output, err := findLayoutByTwoPartKey(ctx, conn, domainID, layoutID)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, layoutID)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &data, fwflex.WithFieldNamePrefix("Layout")))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *layoutResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old layoutResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &new))
+	if response.Diagnostics.HasError() {
+		return
+	}
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &old))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	diff, d := fwflex.Diff(ctx, new, old)
+	smerr.AddEnrich(ctx, &response.Diagnostics, d)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		layoutID := fwflex.StringValueFromFramework(ctx, new.LayoutID)
+		var input connectcases.UpdateLayoutInput
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, new, &input))
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateLayout(ctx, &input)
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, layoutID)
+			return
+		}
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &new))
+}
+
+func (r *layoutResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data layoutResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	domainID, layoutID := fwflex.StringValueFromFramework(ctx, data.DomainID), fwflex.StringValueFromFramework(ctx, data.LayoutID)
+	input := connectcases.DeleteLayoutInput{
+		DomainId: aws.String(domainID),
+		LayoutId: aws.String(layoutID),
+	}
+	_, err := conn.DeleteLayout(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, layoutID)
+		return
+	}
+}
+
+var (
+	_ inttypes.ImportIDParser = layoutImportID{}
+)
+
+type layoutImportID struct{}
+
+func (layoutImportID) Parse(id string) (string, map[string]any, error) {
+	domainID, layoutID, found := strings.Cut(id, intflex.ResourceIdSeparator)
+	if !found {
+		return "", nil, fmt.Errorf("id %q should be in the format <domain-id>%s<layout-id>", id, intflex.ResourceIdSeparator)
+	}
+
+	result := map[string]any{
+		"domain_id": domainID,
+		"layout_id": layoutID,
+	}
+
+	return id, result, nil
+}
+
+func findLayoutByTwoPartKey(ctx context.Context, conn *connectcases.Client, domainID, layoutID string) (*connectcases.GetLayoutOutput, error) {
+	input := connectcases.GetLayoutInput{
+		DomainId: aws.String(domainID),
+		LayoutId: aws.String(layoutID),
+	}
+	output, err := conn.GetLayout(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError())
+	}
+
+	if output.Deleted {
+		return nil, smarterr.NewError(&retry.NotFoundError{})
+	}
+
+	return output, nil
+}
+
+type layoutResourceModel struct {
+	framework.WithRegionModel
+	ARN      types.String                                        `tfsdk:"arn"`
+	Content  fwtypes.ListNestedObjectValueOf[layoutContentModel] `tfsdk:"content"`
+	DomainID types.String                                        `tfsdk:"domain_id"`
+	LayoutID types.String                                        `tfsdk:"layout_id"`
+	Name     types.String                                        `tfsdk:"name"`
+	Tags     tftags.Map                                          `tfsdk:"tags"`
+	TagsAll  tftags.Map                                          `tfsdk:"tags_all"`
+}
+
+type layoutContentModel struct {
+	Basic fwtypes.ListNestedObjectValueOf[basicLayoutModel] `tfsdk:"basic"`
+}
+
+var (
+	_ fwflex.Expander  = layoutContentModel{}
+	_ fwflex.Flattener = &layoutContentModel{}
+)
+
+func (m *layoutContentModel) Flatten(ctx context.Context, v any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	switch t := v.(type) {
+	case awstypes.LayoutContentMemberBasic:
+		var data basicLayoutModel
+		smerr.AddEnrich(ctx, &diags, fwflex.Flatten(ctx, t.Value, &data))
+		if diags.HasError() {
+			return diags
+		}
+		m.Basic = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+
+	default:
+		diags.AddError(
+			"Unsupported Type",
+			fmt.Sprintf("layout content flatten: %T", v),
+		)
+	}
+	return diags
+}
+
+func (m layoutContentModel) Expand(ctx context.Context) (any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	switch {
+	case !m.Basic.IsNull():
+		data, d := m.Basic.ToPtr(ctx)
+		smerr.AddEnrich(ctx, &diags, d)
+		if diags.HasError() {
+			return nil, diags
+		}
+		var r awstypes.LayoutContentMemberBasic
+		smerr.AddEnrich(ctx, &diags, fwflex.Expand(ctx, data, &r.Value))
+		if diags.HasError() {
+			return nil, diags
+		}
+		return &r, diags
+	}
+	return nil, diags
+}
+
+type basicLayoutModel struct {
+	MoreInfo fwtypes.ListNestedObjectValueOf[layoutSectionsModel] `tfsdk:"more_info"`
+	TopPanel fwtypes.ListNestedObjectValueOf[layoutSectionsModel] `tfsdk:"top_panel"`
+}
+
+type layoutSectionsModel struct {
+	Sections fwtypes.ListNestedObjectValueOf[sectionModel] `tfsdk:"section"`
+}
+
+type sectionModel struct {
+	FieldGroup fwtypes.ListNestedObjectValueOf[fieldGroupModel] `tfsdk:"field_group"`
+}
+
+var (
+	_ fwflex.Expander  = sectionModel{}
+	_ fwflex.Flattener = &sectionModel{}
+)
+
+func (m *sectionModel) Flatten(ctx context.Context, v any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	switch t := v.(type) {
+	case awstypes.SectionMemberFieldGroup:
+		var data fieldGroupModel
+		smerr.AddEnrich(ctx, &diags, fwflex.Flatten(ctx, t.Value, &data))
+		if diags.HasError() {
+			return diags
+		}
+		m.FieldGroup = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+
+	default:
+		diags.AddError(
+			"Unsupported Type",
+			fmt.Sprintf("section flatten: %T", v),
+		)
+	}
+	return diags
+}
+
+func (m sectionModel) Expand(ctx context.Context) (any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	switch {
+	case !m.FieldGroup.IsNull():
+		data, d := m.FieldGroup.ToPtr(ctx)
+		smerr.AddEnrich(ctx, &diags, d)
+		if diags.HasError() {
+			return nil, diags
+		}
+		var r awstypes.SectionMemberFieldGroup
+		smerr.AddEnrich(ctx, &diags, fwflex.Expand(ctx, data, &r.Value))
+		if diags.HasError() {
+			return nil, diags
+		}
+		return &r, diags
+	}
+	return nil, diags
+}
+
+type fieldGroupModel struct {
+	Fields fwtypes.ListNestedObjectValueOf[fieldItemModel] `tfsdk:"field"`
+	Name   types.String                                    `tfsdk:"name"`
+}
+
+type fieldItemModel struct {
+	ID types.String `tfsdk:"id"`
+}
@@ -0,0 +1,221 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package connectcases_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/connectcases"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfknownvalue "github.com/hashicorp/terraform-provider-aws/internal/acctest/knownvalue"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfconnectcases "github.com/hashicorp/terraform-provider-aws/internal/service/connectcases"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccConnectCasesLayout_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v connectcases.GetLayoutOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_connectcases_layout.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectCasesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLayoutDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLayoutConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLayoutExists(ctx, t, resourceName, &v),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrARN), tfknownvalue.RegionalARNRegexp("cases", regexache.MustCompile(`domain/.+/layout/.+$`))),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrContent).AtSliceIndex(0).AtMapKey("basic").AtSliceIndex(0).AtMapKey("more_info").AtSliceIndex(0).AtMapKey("section"), knownvalue.ListSizeExact(1)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrContent).AtSliceIndex(0).AtMapKey("basic").AtSliceIndex(0).AtMapKey("top_panel"), knownvalue.ListSizeExact(0)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("layout_id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrName), knownvalue.StringExact(rName)),
+				},
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateIdFunc:                    acctest.AttrsImportStateIdFunc(resourceName, ",", "domain_id", "layout_id"),
+				ImportStateVerifyIdentifierAttribute: "layout_id",
+			},
+			{
+				Config: testAccLayoutConfig_topPanel(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLayoutExists(ctx, t, resourceName, &v),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrContent).AtSliceIndex(0).AtMapKey("basic").AtSliceIndex(0).AtMapKey("top_panel").AtSliceIndex(0).AtMapKey("section"), knownvalue.ListSizeExact(1)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccConnectCasesLayout_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v connectcases.GetLayoutOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_connectcases_layout.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectCasesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLayoutDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLayoutConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLayoutExists(ctx, t, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, t, tfconnectcases.ResourceLayout, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckLayoutDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).ConnectCasesClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_connectcases_layout" {
+				continue
+			}
+
+			_, err := tfconnectcases.FindLayoutByTwoPartKey(ctx, conn, rs.Primary.Attributes["domain_id"], rs.Primary.Attributes["layout_id"])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Connect Cases Layout %s still exists", rs.Primary.Attributes["layout_id"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckLayoutExists(ctx context.Context, t *testing.T, n string, v *connectcases.GetLayoutOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).ConnectCasesClient(ctx)
+
+		output, err := tfconnectcases.FindLayoutByTwoPartKey(ctx, conn, rs.Primary.Attributes["domain_id"], rs.Primary.Attributes["layout_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccLayoutConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccFieldConfig_basic(rName), fmt.Sprintf(`
+resource "aws_connectcases_layout" "test" {
+  domain_id = aws_connectcases_domain.test.domain_id
+  name      = %[1]q
+
+  content {
+    basic {
+      more_info {
+        section {
+          field_group {
+            name = %[1]q
+
+            field {
+              id = aws_connectcases_field.test.field_id
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccLayoutConfig_topPanel(rName string) string {
+	return acctest.ConfigCompose(testAccFieldConfig_basic(rName), fmt.Sprintf(`
+resource "aws_connectcases_layout" "test" {
+  domain_id = aws_connectcases_domain.test.domain_id
+  name      = %[1]q
+
+  content {
+    basic {
+      more_info {
+        section {
+          field_group {
+            name = %[1]q
+
+            field {
+              id = aws_connectcases_field.test.field_id
+            }
+          }
+        }
+      }
+
+      top_panel {
+        section {
+          field_group {
+            field {
+              id = aws_connectcases_field.test.field_id
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`, rName))
+}
@@ -0,0 +1,371 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package connectcases
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connectcases"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/connectcases/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_connectcases_template", name="Template")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("domain_id")
+// @IdentityAttribute("template_id")
+// @ImportIDHandler("templateImportID")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/connectcases;connectcases;connectcases.GetTemplateOutput")
+// @Testing(importStateIdAttributes="domain_id;template_id", importStateIdAttributesSep="flex.ResourceIdSeparator")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(hasNoPreExistingResource=true)
+func newTemplateResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &templateResource{}, nil
+}
+
+type templateResource struct {
+	framework.ResourceWithModel[templateResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *templateResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN:         framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{Optional: true},
+			"domain_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.TemplateStatus](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"template_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"layout_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[layoutConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"default_layout": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			"required_field": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[requiredFieldModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"field_id": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			names.AttrRule: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[templateRuleModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"case_rule_id": schema.StringAttribute{
+							Required: true,
+						},
+						"field_id": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			"tag_propagation_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[tagPropagationConfigurationModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrResourceType: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.TagPropagationResourceType](),
+							Required:   true,
+						},
+						"tag_map": schema.MapAttribute{
+							CustomType:  fwtypes.MapOfStringType,
+							ElementType: types.StringType,
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *templateResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan templateResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, plan.Name)
+	var input connectcases.CreateTemplateInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, plan, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateTemplate(ctx, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	domainID, templateID := fwflex.StringValueFromFramework(ctx, plan.DomainID), aws.ToString(output.TemplateId)
+
+	// CreateTemplate doesn't accept tags.
+	if err := createTags(ctx, conn, aws.ToString(output.TemplateArn), getTagsIn(ctx)); err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, templateID)
+		return
+	}
+
+	template, err := findTemplateByTwoPartKey(ctx, conn, domainID, templateID)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, templateID)
+		return
+	}
+
+	// Set values for unknowns.
+	plan.ARN = fwflex.StringToFramework(ctx, template.TemplateArn)
+	plan.Status = fwtypes.StringEnumValue(template.Status)
+	plan.TemplateID = fwflex.StringValueToFramework(ctx, templateID)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, plan))
+}
+
+func (r *templateResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data templateResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	domainID, templateID := fwflex.StringValueFromFramework(ctx, data.DomainID), fwflex.StringValueFromFramework(ctx, data.TemplateID)
+	output, err := findTemplateByTwoPartKey(ctx, conn, domainID, templateID)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, templateID)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &data, fwflex.WithFieldNamePrefix("Template")))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *templateResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old templateResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &new))
+	if response.Diagnostics.HasError() {
+		return
+	}
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &old))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	diff, d := fwflex.Diff(ctx, new, old)
+	smerr.AddEnrich(ctx, &response.Diagnostics, d)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		templateID := fwflex.StringValueFromFramework(ctx, new.TemplateID)
+		var input connectcases.UpdateTemplateInput
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, new, &input))
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Empty lists are needed to remove all required fields and rules.
+		if input.RequiredFields == nil {
+			input.RequiredFields = []awstypes.RequiredField{}
+		}
+		if input.Rules == nil {
+			input.Rules = []awstypes.TemplateRule{}
+		}
+
+		_, err := conn.UpdateTemplate(ctx, &input)
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, templateID)
+			return
+		}
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &new))
+}
+
+func (r *templateResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data templateResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	domainID, templateID := fwflex.StringValueFromFramework(ctx, data.DomainID), fwflex.StringValueFromFramework(ctx, data.TemplateID)
+	input := connectcases.DeleteTemplateInput{
+		DomainId:   aws.String(domainID),
+		TemplateId: aws.String(templateID),
+	}
+	_, err := conn.DeleteTemplate(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, templateID)
+		return
+	}
+}
+
+var (
+	_ inttypes.ImportIDParser = templateImportID{}
+)
+
+type templateImportID struct{}
+
+func (templateImportID) Parse(id string) (string, map[string]any, error) {
+	domainID, templateID, found := strings.Cut(id, intflex.ResourceIdSeparator)
+	if !found {
+		return "", nil, fmt.Errorf("id %q should be in the format <domain-id>%s<template-id>", id, intflex.ResourceIdSeparator)
+	}
+
+	result := map[string]any{
+		"domain_id":   domainID,
+		"template_id": templateID,
+	}
+
+	return id, result, nil
+}
+
+func findTemplateByTwoPartKey(ctx context.Context, conn *connectcases.Client, domainID, templateID string) (*connectcases.GetTemplateOutput, error) {
+	input := connectcases.GetTemplateInput{
+		DomainId:   aws.String(domainID),
+		TemplateId: aws.String(templateID),
+	}
+	output, err := conn.GetTemplate(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError())
+	}
+
+	if output.Deleted {
+		return nil, smarterr.NewError(&retry.NotFoundError{})
+	}
+
+	return output, nil
+}
+
+type templateResourceModel struct {
+	framework.WithRegionModel
+	ARN                          types.String                                                      `tfsdk:"arn"`
+	Description                  types.String                                                      `tfsdk:"description"`
+	DomainID                     types.String                                                      `tfsdk:"domain_id"`
+	LayoutConfiguration          fwtypes.ListNestedObjectValueOf[layoutConfigurationModel]         `tfsdk:"layout_configuration"`
+	Name                         types.String                                                      `tfsdk:"name"`
+	RequiredFields               fwtypes.ListNestedObjectValueOf[requiredFieldModel]               `tfsdk:"required_field"`
+	Rules                        fwtypes.ListNestedObjectValueOf[templateRuleModel]                `tfsdk:"rule"`
+	Status                       fwtypes.StringEnum[awstypes.TemplateStatus]                       `tfsdk:"status"`
+	TagPropagationConfigurations fwtypes.ListNestedObjectValueOf[tagPropagationConfigurationModel] `tfsdk:"tag_propagation_configuration"`
+	Tags                         tftags.Map                                                        `tfsdk:"tags"`
+	TagsAll                      tftags.Map                                                        `tfsdk:"tags_all"`
+	TemplateID                   types.String                                                      `tfsdk:"template_id"`
+}
+
+type layoutConfigurationModel struct {
+	DefaultLayout types.String `tfsdk:"default_layout"`
+}
+
+type requiredFieldModel struct {
+	FieldID types.String `tfsdk:"field_id"`
+}
+
+type templateRuleModel struct {
+	CaseRuleID types.String `tfsdk:"case_rule_id"`
+	FieldID    types.String `tfsdk:"field_id"`
+}
+
+type tagPropagationConfigurationModel struct {
+	ResourceType fwtypes.StringEnum[awstypes.TagPropagationResourceType] `tfsdk:"resource_type"`
+	TagMap       fwtypes.MapOfString                                     `tfsdk:"tag_map"`
+}
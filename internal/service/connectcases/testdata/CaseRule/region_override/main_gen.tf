@@ -0,0 +1,55 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_connectcases_case_rule" "test" {
+  region = var.region
+
+  domain_id = aws_connectcases_domain.test.domain_id
+  name      = var.rName
+
+  rule {
+    required {
+      default_value = false
+
+      condition {
+        equal_to {
+          result = true
+
+          operand_one {
+            field_id = aws_connectcases_field.test.field_id
+          }
+
+          operand_two {
+            boolean_value = true
+          }
+        }
+      }
+    }
+  }
+}
+
+resource "aws_connectcases_field" "test" {
+  region = var.region
+
+  domain_id = aws_connectcases_domain.test.domain_id
+  name      = var.rName
+  type      = "Boolean"
+}
+
+resource "aws_connectcases_domain" "test" {
+  region = var.region
+
+  name = var.rName
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "region" {
+  description = "Region to deploy resource in"
+  type        = string
+  nullable    = false
+}
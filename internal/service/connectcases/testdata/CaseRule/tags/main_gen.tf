@@ -0,0 +1,52 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_connectcases_case_rule" "test" {
+  domain_id = aws_connectcases_domain.test.domain_id
+  name      = var.rName
+
+  rule {
+    required {
+      default_value = false
+
+      condition {
+        equal_to {
+          result = true
+
+          operand_one {
+            field_id = aws_connectcases_field.test.field_id
+          }
+
+          operand_two {
+            boolean_value = true
+          }
+        }
+      }
+    }
+  }
+
+  tags = var.resource_tags
+}
+
+resource "aws_connectcases_field" "test" {
+  domain_id = aws_connectcases_domain.test.domain_id
+  name      = var.rName
+  type      = "Boolean"
+}
+
+resource "aws_connectcases_domain" "test" {
+  name = var.rName
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "resource_tags" {
+  description = "Tags to set on resource. To specify no tags, set to `null`"
+  # Not setting a default, so that this must explicitly be set to `null` to specify no tags
+  type     = map(string)
+  nullable = true
+}
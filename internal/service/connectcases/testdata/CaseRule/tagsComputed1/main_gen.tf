@@ -0,0 +1,56 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+provider "null" {}
+
+resource "aws_connectcases_case_rule" "test" {
+  domain_id = aws_connectcases_domain.test.domain_id
+  name      = var.rName
+
+  rule {
+    required {
+      default_value = false
+
+      condition {
+        equal_to {
+          result = true
+
+          operand_one {
+            field_id = aws_connectcases_field.test.field_id
+          }
+
+          operand_two {
+            boolean_value = true
+          }
+        }
+      }
+    }
+  }
+
+  tags = {
+    (var.unknownTagKey) = null_resource.test.id
+  }
+}
+
+resource "aws_connectcases_field" "test" {
+  domain_id = aws_connectcases_domain.test.domain_id
+  name      = var.rName
+  type      = "Boolean"
+}
+
+resource "aws_connectcases_domain" "test" {
+  name = var.rName
+}
+
+resource "null_resource" "test" {}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "unknownTagKey" {
+  type     = string
+  nullable = false
+}
@@ -0,0 +1,51 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_connectcases_layout" "test" {
+  region = var.region
+
+  domain_id = aws_connectcases_domain.test.domain_id
+  name      = var.rName
+
+  content {
+    basic {
+      more_info {
+        section {
+          field_group {
+            name = var.rName
+
+            field {
+              id = aws_connectcases_field.test.field_id
+            }
+          }
+        }
+      }
+    }
+  }
+}
+
+resource "aws_connectcases_field" "test" {
+  region = var.region
+
+  domain_id = aws_connectcases_domain.test.domain_id
+  name      = var.rName
+  type      = "Text"
+}
+
+resource "aws_connectcases_domain" "test" {
+  region = var.region
+
+  name = var.rName
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "region" {
+  description = "Region to deploy resource in"
+  type        = string
+  nullable    = false
+}
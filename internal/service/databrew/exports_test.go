@@ -0,0 +1,26 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew
+
+// Exports for use in tests only.
+var (
+	ResourceDataset    = newDatasetResource
+	ResourceProfileJob = newProfileJobResource
+	ResourceProject    = newProjectResource
+	ResourceRecipe     = newRecipeResource
+	ResourceRecipeJob  = newRecipeJobResource
+	ResourceRuleset    = newRulesetResource
+	ResourceSchedule   = newScheduleResource
+
+	FindDatasetByName      = findDatasetByName
+	FindJobByTwoPartKey    = findJobByTwoPartKey
+	FindProjectByName      = findProjectByName
+	FindRecipeByTwoPartKey = findRecipeByTwoPartKey
+	FindRulesetByName      = findRulesetByName
+	FindScheduleByName     = findScheduleByName
+)
+
+const (
+	RecipeVersionLatestWorking = recipeVersionLatestWorking
+)
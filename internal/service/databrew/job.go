@@ -0,0 +1,68 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew
+
+import (
+	"context"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/databrew"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/databrew/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func deleteJob(ctx context.Context, conn *databrew.Client, name string) error {
+	input := databrew.DeleteJobInput{
+		Name: aws.String(name),
+	}
+	_, err := conn.DeleteJob(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	return err
+}
+
+func findJobByTwoPartKey(ctx context.Context, conn *databrew.Client, name string, jobType awstypes.JobType) (*databrew.DescribeJobOutput, error) {
+	output, err := findJobByName(ctx, conn, name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.Type != jobType {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			Message: "unexpected job type: " + string(output.Type),
+		})
+	}
+
+	return output, nil
+}
+
+func findJobByName(ctx context.Context, conn *databrew.Client, name string) (*databrew.DescribeJobOutput, error) {
+	input := databrew.DescribeJobInput{
+		Name: aws.String(name),
+	}
+	output, err := conn.DescribeJob(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError())
+	}
+
+	return output, nil
+}
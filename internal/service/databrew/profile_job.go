@@ -0,0 +1,514 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/databrew"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/databrew/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// defaultProfileJobSampleSize is the number of rows DataBrew profiles when a job has no sample configured.
+	defaultProfileJobSampleSize = 20000
+)
+
+// @FrameworkResource("aws_databrew_profile_job", name="Profile Job")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("name")
+// @Testing(importStateIdAttribute="name")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/databrew;databrew.DescribeJobOutput")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(hasNoPreExistingResource=true)
+func newProfileJobResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &profileJobResource{}
+
+	return r, nil
+}
+
+type profileJobResource struct {
+	framework.ResourceWithModel[profileJobResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *profileJobResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	columnSelectorBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[columnSelectorModel](ctx),
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					names.AttrName: schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							stringvalidator.LengthBetween(1, 255),
+						},
+					},
+					"regex": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							stringvalidator.LengthBetween(1, 255),
+						},
+					},
+				},
+			},
+		}
+	}
+	statisticsConfigurationBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[statisticsConfigurationModel](ctx),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"included_statistics": schema.ListAttribute{
+						CustomType: fwtypes.ListOfStringType,
+						Optional:   true,
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"override": schema.ListNestedBlock{
+						CustomType: fwtypes.NewListNestedObjectTypeOf[statisticOverrideModel](ctx),
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								names.AttrParameters: schema.MapAttribute{
+									CustomType: fwtypes.MapOfStringType,
+									Required:   true,
+								},
+								"statistic": schema.StringAttribute{
+									Required: true,
+									Validators: []validator.String{
+										stringvalidator.LengthBetween(1, 128),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"dataset_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"encryption_key_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			"encryption_mode": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EncryptionMode](),
+				Optional:   true,
+			},
+			"log_subscription": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.LogSubscription](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"max_capacity": schema.Int32Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"max_retries": schema.Int32Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 240),
+				},
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrTimeout: schema.Int32Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrConfiguration: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[profileConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"column_statistics_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[columnStatisticsConfigurationModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"selector":   columnSelectorBlock(),
+									"statistics": statisticsConfigurationBlock(),
+								},
+							},
+						},
+						"dataset_statistics_configuration": statisticsConfigurationBlock(),
+						"entity_detector_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[entityDetectorConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"entity_types": schema.ListAttribute{
+										CustomType: fwtypes.ListOfStringType,
+										Required:   true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"allowed_statistics": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[allowedStatisticsModel](ctx),
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"statistics": schema.ListAttribute{
+													CustomType: fwtypes.ListOfStringType,
+													Required:   true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"profile_column": columnSelectorBlock(),
+					},
+				},
+			},
+			"job_sample": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[jobSampleModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrMode: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.SampleMode](),
+							Optional:   true,
+							Computed:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						names.AttrSize: schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Int64{
+								int64planmodifier.UseStateForUnknown(),
+							},
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+					},
+				},
+			},
+			"output_location": s3LocationBlock(ctx, true),
+			"validation_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[validationConfigurationModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"ruleset_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+						"validation_mode": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.ValidationMode](),
+							Optional:   true,
+							Computed:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *profileJobResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan profileJobResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, plan.Name)
+	var input databrew.CreateProfileJobInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, plan, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	_, err := conn.CreateProfileJob(ctx, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	output, err := findJobByTwoPartKey(ctx, conn, name, awstypes.JobTypeProfile)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, flattenProfileJob(ctx, output, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, plan))
+}
+
+func (r *profileJobResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data profileJobResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, data.Name)
+	output, err := findJobByTwoPartKey(ctx, conn, name, awstypes.JobTypeProfile)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, flattenProfileJob(ctx, output, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *profileJobResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old profileJobResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &new))
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &old))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	diff, d := fwflex.Diff(ctx, new, old)
+	smerr.AddEnrich(ctx, &response.Diagnostics, d)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		name := fwflex.StringValueFromFramework(ctx, new.Name)
+		var input databrew.UpdateProfileJobInput
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, new, &input))
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateProfileJob(ctx, &input)
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+			return
+		}
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &new))
+}
+
+func (r *profileJobResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data profileJobResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, data.Name)
+	if err := deleteJob(ctx, conn, name); err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+}
+
+// flattenProfileJob flattens the profile job into the resource model.
+// DescribeJob returns the profile job's configuration as ProfileConfiguration
+// and its output location in the generic Outputs list.
+func flattenProfileJob(ctx context.Context, output *databrew.DescribeJobOutput, data *profileJobResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	jobSampleNull := data.JobSample.IsNull()
+
+	diags.Append(fwflex.Flatten(ctx, output, data, fwflex.WithFieldNamePrefix("Resource"))...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if v := output.JobSample; jobSampleNull && v != nil && v.Mode == awstypes.SampleModeCustomRows && aws.ToInt64(v.Size) == defaultProfileJobSampleSize {
+		data.JobSample = fwtypes.NewListNestedObjectValueOfNull[jobSampleModel](ctx)
+	}
+
+	if v := output.ProfileConfiguration; v != nil {
+		diags.Append(fwflex.Flatten(ctx, v, &data.Configuration)...)
+		if diags.HasError() {
+			return diags
+		}
+	} else {
+		data.Configuration = fwtypes.NewListNestedObjectValueOfNull[profileConfigurationModel](ctx)
+	}
+
+	if len(output.Outputs) > 0 {
+		diags.Append(fwflex.Flatten(ctx, output.Outputs[0].Location, &data.OutputLocation)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
+}
+
+type profileJobResourceModel struct {
+	framework.WithRegionModel
+	ARN                      types.String                                                  `tfsdk:"arn"`
+	Configuration            fwtypes.ListNestedObjectValueOf[profileConfigurationModel]    `tfsdk:"configuration"`
+	DatasetName              types.String                                                  `tfsdk:"dataset_name"`
+	EncryptionKeyARN         fwtypes.ARN                                                   `tfsdk:"encryption_key_arn"`
+	EncryptionMode           fwtypes.StringEnum[awstypes.EncryptionMode]                   `tfsdk:"encryption_mode"`
+	JobSample                fwtypes.ListNestedObjectValueOf[jobSampleModel]               `tfsdk:"job_sample"`
+	LogSubscription          fwtypes.StringEnum[awstypes.LogSubscription]                  `tfsdk:"log_subscription"`
+	MaxCapacity              types.Int32                                                   `tfsdk:"max_capacity"`
+	MaxRetries               types.Int32                                                   `tfsdk:"max_retries"`
+	Name                     types.String                                                  `tfsdk:"name"`
+	OutputLocation           fwtypes.ListNestedObjectValueOf[s3LocationModel]              `tfsdk:"output_location"`
+	RoleARN                  fwtypes.ARN                                                   `tfsdk:"role_arn"`
+	Tags                     tftags.Map                                                    `tfsdk:"tags"`
+	TagsAll                  tftags.Map                                                    `tfsdk:"tags_all"`
+	Timeout                  types.Int32                                                   `tfsdk:"timeout"`
+	ValidationConfigurations fwtypes.ListNestedObjectValueOf[validationConfigurationModel] `tfsdk:"validation_configuration"`
+}
+
+type profileConfigurationModel struct {
+	ColumnStatisticsConfigurations fwtypes.ListNestedObjectValueOf[columnStatisticsConfigurationModel] `tfsdk:"column_statistics_configuration"`
+	DatasetStatisticsConfiguration fwtypes.ListNestedObjectValueOf[statisticsConfigurationModel]       `tfsdk:"dataset_statistics_configuration"`
+	EntityDetectorConfiguration    fwtypes.ListNestedObjectValueOf[entityDetectorConfigurationModel]   `tfsdk:"entity_detector_configuration"`
+	ProfileColumns                 fwtypes.ListNestedObjectValueOf[columnSelectorModel]                `tfsdk:"profile_column"`
+}
+
+type columnStatisticsConfigurationModel struct {
+	Selectors  fwtypes.ListNestedObjectValueOf[columnSelectorModel]          `tfsdk:"selector"`
+	Statistics fwtypes.ListNestedObjectValueOf[statisticsConfigurationModel] `tfsdk:"statistics"`
+}
+
+type statisticsConfigurationModel struct {
+	IncludedStatistics fwtypes.ListOfString                                    `tfsdk:"included_statistics"`
+	Overrides          fwtypes.ListNestedObjectValueOf[statisticOverrideModel] `tfsdk:"override"`
+}
+
+type statisticOverrideModel struct {
+	Parameters fwtypes.MapOfString `tfsdk:"parameters"`
+	Statistic  types.String        `tfsdk:"statistic"`
+}
+
+type entityDetectorConfigurationModel struct {
+	AllowedStatistics fwtypes.ListNestedObjectValueOf[allowedStatisticsModel] `tfsdk:"allowed_statistics"`
+	EntityTypes       fwtypes.ListOfString                                    `tfsdk:"entity_types"`
+}
+
+type allowedStatisticsModel struct {
+	Statistics fwtypes.ListOfString `tfsdk:"statistics"`
+}
+
+type columnSelectorModel struct {
+	Name  types.String `tfsdk:"name"`
+	Regex types.String `tfsdk:"regex"`
+}
+
+type jobSampleModel struct {
+	Mode fwtypes.StringEnum[awstypes.SampleMode] `tfsdk:"mode"`
+	Size types.Int64                             `tfsdk:"size"`
+}
+
+type validationConfigurationModel struct {
+	RulesetARN     fwtypes.ARN                                 `tfsdk:"ruleset_arn"`
+	ValidationMode fwtypes.StringEnum[awstypes.ValidationMode] `tfsdk:"validation_mode"`
+}
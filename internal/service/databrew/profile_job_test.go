@@ -0,0 +1,332 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/databrew"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/databrew/types"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfknownvalue "github.com/hashicorp/terraform-provider-aws/internal/acctest/knownvalue"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfdatabrew "github.com/hashicorp/terraform-provider-aws/internal/service/databrew"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDataBrewProfileJob_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v databrew.DescribeJobOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_databrew_profile_job.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProfileJobDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfileJobConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProfileJobExists(ctx, t, resourceName, &v),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrARN), tfknownvalue.RegionalARNExact("databrew", "job/"+rName)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrConfiguration), knownvalue.ListSizeExact(0)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("dataset_name"), knownvalue.StringExact(rName)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("job_sample"), knownvalue.ListSizeExact(0)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrName), knownvalue.StringExact(rName)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("output_location"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							names.AttrBucket: knownvalue.StringExact(rName),
+							"bucket_owner":   knownvalue.Null(),
+							names.AttrKey:    knownvalue.StringExact("profile/"),
+						}),
+					})),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.Null()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("validation_configuration"), knownvalue.ListSizeExact(0)),
+				},
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrName,
+			},
+		},
+	})
+}
+
+func TestAccDataBrewProfileJob_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v databrew.DescribeJobOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_databrew_profile_job.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProfileJobDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfileJobConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProfileJobExists(ctx, t, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, t, tfdatabrew.ResourceProfileJob, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccDataBrewProfileJob_configuration(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v databrew.DescribeJobOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_databrew_profile_job.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProfileJobDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfileJobConfig_configuration(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProfileJobExists(ctx, t, resourceName, &v),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrConfiguration), knownvalue.ListSizeExact(1)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrConfiguration).AtSliceIndex(0).AtMapKey("dataset_statistics_configuration").AtSliceIndex(0).AtMapKey("included_statistics"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringExact("CORRELATION"),
+					})),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrConfiguration).AtSliceIndex(0).AtMapKey("profile_column"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							names.AttrName: knownvalue.StringExact(names.AttrName),
+							"regex":        knownvalue.Null(),
+						}),
+					})),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("job_sample"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							names.AttrMode: knownvalue.StringExact("CUSTOM_ROWS"),
+							names.AttrSize: knownvalue.Int64Exact(100),
+						}),
+					})),
+				},
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrName,
+			},
+		},
+	})
+}
+
+func TestAccDataBrewProfileJob_validationConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v databrew.DescribeJobOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_databrew_profile_job.test"
+	rulesetResourceName := "aws_databrew_ruleset.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProfileJobDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfileJobConfig_validationConfiguration(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProfileJobExists(ctx, t, resourceName, &v),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("validation_configuration"), knownvalue.ListSizeExact(1)),
+					statecheck.CompareValuePairs(resourceName, tfjsonpath.New("validation_configuration").AtSliceIndex(0).AtMapKey("ruleset_arn"), rulesetResourceName, tfjsonpath.New(names.AttrARN), compare.ValuesSame()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("validation_configuration").AtSliceIndex(0).AtMapKey("validation_mode"), knownvalue.StringExact("CHECK_ALL")),
+				},
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrName,
+			},
+		},
+	})
+}
+
+func testAccCheckProfileJobDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).DataBrewClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_databrew_profile_job" {
+				continue
+			}
+
+			_, err := tfdatabrew.FindJobByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrName], awstypes.JobTypeProfile)
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("DataBrew Profile Job %s still exists", rs.Primary.Attributes[names.AttrName])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckProfileJobExists(ctx context.Context, t *testing.T, n string, v *databrew.DescribeJobOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).DataBrewClient(ctx)
+
+		output, err := tfdatabrew.FindJobByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrName], awstypes.JobTypeProfile)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccProfileJobConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccDatasetConfig_basic(rName), testAccServiceRoleConfig_base(rName))
+}
+
+func testAccProfileJobConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccProfileJobConfig_base(rName), fmt.Sprintf(`
+resource "aws_databrew_profile_job" "test" {
+  name         = %[1]q
+  dataset_name = aws_databrew_dataset.test.name
+  role_arn     = aws_iam_role.test.arn
+
+  output_location {
+    bucket = aws_s3_bucket.test.bucket
+    key    = "profile/"
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName))
+}
+
+func testAccProfileJobConfig_configuration(rName string) string {
+	return acctest.ConfigCompose(testAccProfileJobConfig_base(rName), fmt.Sprintf(`
+resource "aws_databrew_profile_job" "test" {
+  name         = %[1]q
+  dataset_name = aws_databrew_dataset.test.name
+  role_arn     = aws_iam_role.test.arn
+
+  configuration {
+    dataset_statistics_configuration {
+      included_statistics = ["CORRELATION"]
+    }
+
+    profile_column {
+      name = "name"
+    }
+  }
+
+  job_sample {
+    mode = "CUSTOM_ROWS"
+    size = 100
+  }
+
+  output_location {
+    bucket = aws_s3_bucket.test.bucket
+    key    = "profile/"
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName))
+}
+
+func testAccProfileJobConfig_validationConfiguration(rName string) string {
+	return acctest.ConfigCompose(testAccProfileJobConfig_base(rName), fmt.Sprintf(`
+resource "aws_databrew_ruleset" "test" {
+  name       = %[1]q
+  target_arn = aws_databrew_dataset.test.arn
+
+  rule {
+    name             = "row-count"
+    check_expression = "AGG(ROW_COUNT) > :val1"
+    substitution_map = {
+      ":val1" = "0"
+    }
+  }
+}
+
+resource "aws_databrew_profile_job" "test" {
+  name         = %[1]q
+  dataset_name = aws_databrew_dataset.test.name
+  role_arn     = aws_iam_role.test.arn
+
+  output_location {
+    bucket = aws_s3_bucket.test.bucket
+    key    = "profile/"
+  }
+
+  validation_configuration {
+    ruleset_arn = aws_databrew_ruleset.test.arn
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName))
+}
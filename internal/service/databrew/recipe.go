@@ -0,0 +1,511 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/databrew"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/databrew/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// recipeVersionLatestWorking identifies the editable working version of a recipe.
+	recipeVersionLatestWorking = "LATEST_WORKING"
+	// batchDeleteRecipeVersionChunkSize is the number of versions deleted by each BatchDeleteRecipeVersion call.
+	batchDeleteRecipeVersionChunkSize = 50
+)
+
+// @FrameworkResource("aws_databrew_recipe", name="Recipe")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("name")
+// @Testing(importStateIdAttribute="name")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/databrew;databrew.DescribeRecipeOutput")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(hasNoPreExistingResource=true)
+func newRecipeResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &recipeResource{}
+
+	return r, nil
+}
+
+type recipeResource struct {
+	framework.ResourceWithModel[recipeResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *recipeResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(1024),
+				},
+			},
+			"latest_published_version": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"publish": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"step": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[recipeStepModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						names.AttrAction: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[recipeActionModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"operation": schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 128),
+										},
+									},
+									names.AttrParameters: schema.MapAttribute{
+										CustomType: fwtypes.MapOfStringType,
+										Optional:   true,
+									},
+								},
+							},
+						},
+						"condition_expression": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[conditionExpressionModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrCondition: schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 128),
+										},
+									},
+									"target_column": schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 1024),
+										},
+									},
+									names.AttrValue: schema.StringAttribute{
+										Optional: true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(1024),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *recipeResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan recipeResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, plan.Name)
+	var input databrew.CreateRecipeInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, plan, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	_, err := conn.CreateRecipe(ctx, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	if plan.Publish.ValueBool() {
+		if err := publishRecipe(ctx, conn, name, fwflex.StringFromFramework(ctx, plan.Description)); err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+			return
+		}
+	}
+
+	output, err := findRecipeByTwoPartKey(ctx, conn, name, recipeVersionLatestWorking)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, flattenRecipe(ctx, conn, output, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, plan))
+}
+
+func (r *recipeResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data recipeResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, data.Name)
+	output, err := findRecipeByTwoPartKey(ctx, conn, name, recipeVersionLatestWorking)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, flattenRecipe(ctx, conn, output, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Publishing is a provider-side setting; default it on import.
+	if data.Publish.IsNull() {
+		data.Publish = types.BoolValue(false)
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *recipeResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old recipeResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &new))
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &old))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, new.Name)
+	diff, d := fwflex.Diff(ctx, new, old, fwflex.WithIgnoredField("Publish"))
+	smerr.AddEnrich(ctx, &response.Diagnostics, d)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		var input databrew.UpdateRecipeInput
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, new, &input))
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateRecipe(ctx, &input)
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+			return
+		}
+	}
+
+	// Publish a new version whenever the working version changes, or when publishing is first enabled.
+	if new.Publish.ValueBool() && (diff.HasChanges() || !old.Publish.ValueBool()) {
+		if err := publishRecipe(ctx, conn, name, fwflex.StringFromFramework(ctx, new.Description)); err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+			return
+		}
+	}
+
+	output, err := findRecipeByTwoPartKey(ctx, conn, name, recipeVersionLatestWorking)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, flattenRecipe(ctx, conn, output, &new))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &new))
+}
+
+func (r *recipeResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data recipeResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, data.Name)
+	if err := deleteRecipe(ctx, conn, name); err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+}
+
+// flattenRecipe flattens the recipe's working version into the resource model and records its latest published version.
+func flattenRecipe(ctx context.Context, conn *databrew.Client, output *databrew.DescribeRecipeOutput, data *recipeResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(fwflex.Flatten(ctx, output, data, fwflex.WithFieldNamePrefix("Resource"))...)
+	if diags.HasError() {
+		return diags
+	}
+
+	version, err := findLatestPublishedRecipeVersionByName(ctx, conn, aws.ToString(output.Name))
+
+	switch {
+	case retry.NotFound(err):
+		data.LatestPublishedVersion = types.StringNull()
+	case err != nil:
+		smerr.AddError(ctx, &diags, err, smerr.ID, aws.ToString(output.Name))
+		return diags
+	default:
+		data.LatestPublishedVersion = fwflex.StringToFramework(ctx, version.RecipeVersion)
+	}
+
+	return diags
+}
+
+func publishRecipe(ctx context.Context, conn *databrew.Client, name string, description *string) error {
+	input := databrew.PublishRecipeInput{
+		Description: description,
+		Name:        aws.String(name),
+	}
+	_, err := conn.PublishRecipe(ctx, &input)
+
+	if err != nil {
+		return fmt.Errorf("publishing DataBrew Recipe (%s): %w", name, err)
+	}
+
+	return nil
+}
+
+// deleteRecipe deletes all of a recipe's published versions and then its working version.
+// The working version can only be deleted once no published versions remain.
+func deleteRecipe(ctx context.Context, conn *databrew.Client, name string) error {
+	versions, err := findPublishedRecipeVersionsByName(ctx, conn, name)
+
+	if retry.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	for chunk := range slices.Chunk(versions, batchDeleteRecipeVersionChunkSize) {
+		input := databrew.BatchDeleteRecipeVersionInput{
+			Name:           aws.String(name),
+			RecipeVersions: chunk,
+		}
+		output, err := conn.BatchDeleteRecipeVersion(ctx, &input)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting DataBrew Recipe (%s) versions: %w", name, err)
+		}
+
+		if err := errors.Join(tfslices.ApplyToAll(output.Errors, func(v awstypes.RecipeVersionErrorDetail) error {
+			return fmt.Errorf("deleting DataBrew Recipe (%s) version (%s): %s: %s", name, aws.ToString(v.RecipeVersion), aws.ToString(v.ErrorCode), aws.ToString(v.ErrorMessage))
+		})...); err != nil {
+			return err
+		}
+	}
+
+	input := databrew.DeleteRecipeVersionInput{
+		Name:          aws.String(name),
+		RecipeVersion: aws.String(recipeVersionLatestWorking),
+	}
+	_, err = conn.DeleteRecipeVersion(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting DataBrew Recipe (%s): %w", name, err)
+	}
+
+	return nil
+}
+
+func findRecipeByTwoPartKey(ctx context.Context, conn *databrew.Client, name, version string) (*databrew.DescribeRecipeOutput, error) {
+	input := databrew.DescribeRecipeInput{
+		Name:          aws.String(name),
+		RecipeVersion: aws.String(version),
+	}
+	output, err := conn.DescribeRecipe(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError())
+	}
+
+	return output, nil
+}
+
+func findLatestPublishedRecipeVersionByName(ctx context.Context, conn *databrew.Client, name string) (*awstypes.Recipe, error) {
+	input := databrew.ListRecipeVersionsInput{
+		Name: aws.String(name),
+	}
+	output, err := findRecipeVersions(ctx, conn, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError())
+	}
+
+	v := slices.MaxFunc(output, func(a, b awstypes.Recipe) int {
+		return aws.ToTime(a.PublishedDate).Compare(aws.ToTime(b.PublishedDate))
+	})
+
+	return &v, nil
+}
+
+func findPublishedRecipeVersionsByName(ctx context.Context, conn *databrew.Client, name string) ([]string, error) {
+	input := databrew.ListRecipeVersionsInput{
+		Name: aws.String(name),
+	}
+	output, err := findRecipeVersions(ctx, conn, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfslices.ApplyToAll(output, func(v awstypes.Recipe) string {
+		return aws.ToString(v.RecipeVersion)
+	}), nil
+}
+
+func findRecipeVersions(ctx context.Context, conn *databrew.Client, input *databrew.ListRecipeVersionsInput) ([]awstypes.Recipe, error) {
+	var output []awstypes.Recipe
+
+	pages := databrew.NewListRecipeVersionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, smarterr.NewError(&retry.NotFoundError{
+				LastError: err,
+			})
+		}
+
+		if err != nil {
+			return nil, smarterr.NewError(err)
+		}
+
+		output = append(output, page.Recipes...)
+	}
+
+	return output, nil
+}
+
+type recipeResourceModel struct {
+	framework.WithRegionModel
+	ARN                    types.String                                     `tfsdk:"arn"`
+	Description            types.String                                     `tfsdk:"description"`
+	LatestPublishedVersion types.String                                     `tfsdk:"latest_published_version"`
+	Name                   types.String                                     `tfsdk:"name"`
+	Publish                types.Bool                                       `tfsdk:"publish"`
+	Steps                  fwtypes.ListNestedObjectValueOf[recipeStepModel] `tfsdk:"step"`
+	Tags                   tftags.Map                                       `tfsdk:"tags"`
+	TagsAll                tftags.Map                                       `tfsdk:"tags_all"`
+}
+
+type recipeStepModel struct {
+	Action               fwtypes.ListNestedObjectValueOf[recipeActionModel]        `tfsdk:"action"`
+	ConditionExpressions fwtypes.ListNestedObjectValueOf[conditionExpressionModel] `tfsdk:"condition_expression"`
+}
+
+type recipeActionModel struct {
+	Operation  types.String        `tfsdk:"operation"`
+	Parameters fwtypes.MapOfString `tfsdk:"parameters"`
+}
+
+type conditionExpressionModel struct {
+	Condition    types.String `tfsdk:"condition"`
+	TargetColumn types.String `tfsdk:"target_column"`
+	Value        types.String `tfsdk:"value"`
+}
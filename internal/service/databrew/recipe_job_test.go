@@ -0,0 +1,271 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/databrew"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/databrew/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfknownvalue "github.com/hashicorp/terraform-provider-aws/internal/acctest/knownvalue"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfdatabrew "github.com/hashicorp/terraform-provider-aws/internal/service/databrew"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDataBrewRecipeJob_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v databrew.DescribeJobOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_databrew_recipe_job.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecipeJobDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecipeJobConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecipeJobExists(ctx, t, resourceName, &v),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrARN), tfknownvalue.RegionalARNExact("databrew", "job/"+rName)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("data_catalog_output"), knownvalue.ListSizeExact(0)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("database_output"), knownvalue.ListSizeExact(0)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("dataset_name"), knownvalue.StringExact(rName)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("log_subscription"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("max_capacity"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("max_retries"), knownvalue.Int32Exact(0)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrName), knownvalue.StringExact(rName)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("output"), knownvalue.ListSizeExact(1)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("project_name"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("recipe_reference"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							names.AttrName:   knownvalue.StringExact(rName),
+							"recipe_version": knownvalue.StringExact("1.0"),
+						}),
+					})),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.Null()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTimeout), knownvalue.NotNull()),
+				},
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrName,
+			},
+		},
+	})
+}
+
+func TestAccDataBrewRecipeJob_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v databrew.DescribeJobOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_databrew_recipe_job.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecipeJobDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecipeJobConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecipeJobExists(ctx, t, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, t, tfdatabrew.ResourceRecipeJob, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccDataBrewRecipeJob_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v databrew.DescribeJobOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_databrew_recipe_job.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecipeJobDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecipeJobConfig_update(rName, 2, 60, "CSV"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecipeJobExists(ctx, t, resourceName, &v),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("max_capacity"), knownvalue.Int32Exact(2)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTimeout), knownvalue.Int32Exact(60)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("output").AtSliceIndex(0).AtMapKey(names.AttrFormat), knownvalue.StringExact("CSV")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("output").AtSliceIndex(0).AtMapKey("overwrite"), knownvalue.Bool(true)),
+				},
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrName,
+			},
+			{
+				Config: testAccRecipeJobConfig_update(rName, 3, 120, "PARQUET"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecipeJobExists(ctx, t, resourceName, &v),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("max_capacity"), knownvalue.Int32Exact(3)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTimeout), knownvalue.Int32Exact(120)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("output").AtSliceIndex(0).AtMapKey(names.AttrFormat), knownvalue.StringExact("PARQUET")),
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckRecipeJobDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).DataBrewClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_databrew_recipe_job" {
+				continue
+			}
+
+			_, err := tfdatabrew.FindJobByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrName], awstypes.JobTypeRecipe)
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("DataBrew Recipe Job %s still exists", rs.Primary.Attributes[names.AttrName])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRecipeJobExists(ctx context.Context, t *testing.T, n string, v *databrew.DescribeJobOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).DataBrewClient(ctx)
+
+		output, err := tfdatabrew.FindJobByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrName], awstypes.JobTypeRecipe)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccRecipeJobConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccDatasetConfig_basic(rName), testAccRecipeConfig_publish(rName, "test", "UPPER_CASE"), testAccServiceRoleConfig_base(rName))
+}
+
+func testAccRecipeJobConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccRecipeJobConfig_base(rName), fmt.Sprintf(`
+resource "aws_databrew_recipe_job" "test" {
+  name         = %[1]q
+  dataset_name = aws_databrew_dataset.test.name
+  role_arn     = aws_iam_role.test.arn
+
+  recipe_reference {
+    name           = aws_databrew_recipe.test.name
+    recipe_version = aws_databrew_recipe.test.latest_published_version
+  }
+
+  output {
+    location {
+      bucket = aws_s3_bucket.test.bucket
+      key    = "output/"
+    }
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName))
+}
+
+func testAccRecipeJobConfig_update(rName string, maxCapacity, timeout int, format string) string {
+	return acctest.ConfigCompose(testAccRecipeJobConfig_base(rName), fmt.Sprintf(`
+resource "aws_databrew_recipe_job" "test" {
+  name         = %[1]q
+  dataset_name = aws_databrew_dataset.test.name
+  role_arn     = aws_iam_role.test.arn
+  max_capacity = %[2]d
+  timeout      = %[3]d
+
+  recipe_reference {
+    name           = aws_databrew_recipe.test.name
+    recipe_version = aws_databrew_recipe.test.latest_published_version
+  }
+
+  output {
+    format    = %[4]q
+    overwrite = true
+
+    location {
+      bucket = aws_s3_bucket.test.bucket
+      key    = "output/"
+    }
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, maxCapacity, timeout, format))
+}
@@ -0,0 +1,293 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/databrew"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfknownvalue "github.com/hashicorp/terraform-provider-aws/internal/acctest/knownvalue"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfdatabrew "github.com/hashicorp/terraform-provider-aws/internal/service/databrew"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDataBrewRuleset_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v databrew.DescribeRulesetOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_databrew_ruleset.test"
+	datasetResourceName := "aws_databrew_dataset.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRulesetDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRulesetConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRulesetExists(ctx, t, resourceName, &v),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrARN), tfknownvalue.RegionalARNExact("databrew", "ruleset/"+rName)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrDescription), knownvalue.Null()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrName), knownvalue.StringExact(rName)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRule), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"check_expression": knownvalue.StringExact("AGG(ROW_COUNT) > :val1"),
+							"column_selector":  knownvalue.ListSizeExact(0),
+							"disabled":         knownvalue.Bool(false),
+							names.AttrName:     knownvalue.StringExact("row-count"),
+							"substitution_map": knownvalue.MapExact(map[string]knownvalue.Check{
+								":val1": knownvalue.StringExact("0"),
+							}),
+							"threshold": knownvalue.ListSizeExact(0),
+						}),
+					})),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.Null()),
+					statecheck.CompareValuePairs(resourceName, tfjsonpath.New(names.AttrTargetARN), datasetResourceName, tfjsonpath.New(names.AttrARN), compare.ValuesSame()),
+				},
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrName,
+			},
+		},
+	})
+}
+
+func TestAccDataBrewRuleset_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v databrew.DescribeRulesetOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_databrew_ruleset.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRulesetDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRulesetConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRulesetExists(ctx, t, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, t, tfdatabrew.ResourceRuleset, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccDataBrewRuleset_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v databrew.DescribeRulesetOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_databrew_ruleset.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRulesetDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRulesetConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRulesetExists(ctx, t, resourceName, &v),
+				),
+			},
+			{
+				Config: testAccRulesetConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRulesetExists(ctx, t, resourceName, &v),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrDescription), knownvalue.StringExact("updated")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRule), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"check_expression": knownvalue.StringExact("AGG(ROW_COUNT) > :val1"),
+							"column_selector":  knownvalue.ListSizeExact(0),
+							"disabled":         knownvalue.Bool(true),
+							names.AttrName:     knownvalue.StringExact("row-count"),
+							"substitution_map": knownvalue.MapExact(map[string]knownvalue.Check{
+								":val1": knownvalue.StringExact("1"),
+							}),
+							"threshold": knownvalue.ListSizeExact(0),
+						}),
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"check_expression": knownvalue.StringExact("> :val1"),
+							"column_selector": knownvalue.ListExact([]knownvalue.Check{
+								knownvalue.ObjectExact(map[string]knownvalue.Check{
+									names.AttrName: knownvalue.StringExact(names.AttrID),
+									"regex":        knownvalue.Null(),
+								}),
+							}),
+							"disabled":     knownvalue.Bool(false),
+							names.AttrName: knownvalue.StringExact("id-positive"),
+							"substitution_map": knownvalue.MapExact(map[string]knownvalue.Check{
+								":val1": knownvalue.StringExact("0"),
+							}),
+							"threshold": knownvalue.ListExact([]knownvalue.Check{
+								knownvalue.ObjectExact(map[string]knownvalue.Check{
+									names.AttrType:  knownvalue.StringExact("GREATER_THAN_OR_EQUAL"),
+									names.AttrUnit:  knownvalue.StringExact("PERCENTAGE"),
+									names.AttrValue: knownvalue.Float64Exact(90),
+								}),
+							}),
+						}),
+					})),
+				},
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrName,
+			},
+		},
+	})
+}
+
+func testAccCheckRulesetDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).DataBrewClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_databrew_ruleset" {
+				continue
+			}
+
+			_, err := tfdatabrew.FindRulesetByName(ctx, conn, rs.Primary.Attributes[names.AttrName])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("DataBrew Ruleset %s still exists", rs.Primary.Attributes[names.AttrName])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRulesetExists(ctx context.Context, t *testing.T, n string, v *databrew.DescribeRulesetOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).DataBrewClient(ctx)
+
+		output, err := tfdatabrew.FindRulesetByName(ctx, conn, rs.Primary.Attributes[names.AttrName])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccRulesetConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccDatasetConfig_basic(rName), fmt.Sprintf(`
+resource "aws_databrew_ruleset" "test" {
+  name       = %[1]q
+  target_arn = aws_databrew_dataset.test.arn
+
+  rule {
+    name             = "row-count"
+    check_expression = "AGG(ROW_COUNT) > :val1"
+    substitution_map = {
+      ":val1" = "0"
+    }
+  }
+}
+`, rName))
+}
+
+func testAccRulesetConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccDatasetConfig_basic(rName), fmt.Sprintf(`
+resource "aws_databrew_ruleset" "test" {
+  name        = %[1]q
+  description = "updated"
+  target_arn  = aws_databrew_dataset.test.arn
+
+  rule {
+    name             = "row-count"
+    check_expression = "AGG(ROW_COUNT) > :val1"
+    disabled         = true
+    substitution_map = {
+      ":val1" = "1"
+    }
+  }
+
+  rule {
+    name             = "id-positive"
+    check_expression = "> :val1"
+    substitution_map = {
+      ":val1" = "0"
+    }
+
+    column_selector {
+      name = "id"
+    }
+
+    threshold {
+      type  = "GREATER_THAN_OR_EQUAL"
+      unit  = "PERCENTAGE"
+      value = 90
+    }
+  }
+}
+`, rName))
+}
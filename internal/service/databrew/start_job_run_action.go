@@ -0,0 +1,145 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/databrew"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/databrew/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/backoff"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_databrew_start_job_run, name="Start Job Run")
+func newStartJobRunAction(context.Context) (action.ActionWithConfigure, error) {
+	return &startJobRunAction{}, nil
+}
+
+type startJobRunAction struct {
+	framework.ActionWithModel[startJobRunActionModel]
+}
+
+type startJobRunActionModel struct {
+	framework.WithRegionModel
+	JobName types.String `tfsdk:"job_name"`
+	Timeout types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *startJobRunAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts a run of a DataBrew recipe or profile job. This action is synchronous and waits for the job run to complete.",
+		Attributes: map[string]schema.Attribute{
+			"job_name": schema.StringAttribute{
+				Description: "Name of the DataBrew job to run",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the job run to complete (default: 3600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+		},
+	}
+}
+
+func (a *startJobRunAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var model startJobRunActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().DataBrewClient(ctx)
+
+	jobName := fwflex.StringValueFromFramework(ctx, model.JobName)
+	timeout := fwactions.TimeoutOr(model.Timeout, 1*time.Hour)
+
+	tflog.Info(ctx, "Starting DataBrew job run", map[string]any{
+		"job_name": jobName,
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Starting DataBrew job run for %s...", jobName)
+
+	input := databrew.StartJobRunInput{
+		Name: aws.String(jobName),
+	}
+	output, err := conn.StartJobRun(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError("Starting DataBrew job run", err.Error())
+		return
+	}
+
+	runID := aws.ToString(output.RunId)
+
+	cb(ctx, "Job run %s started, waiting for completion...", runID)
+
+	// Job runs routinely take several minutes, so poll with backoff.
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*databrew.DescribeJobRunOutput], error) {
+		input := databrew.DescribeJobRunInput{
+			Name:  aws.String(jobName),
+			RunId: aws.String(runID),
+		}
+		output, err := conn.DescribeJobRun(ctx, &input)
+		if err != nil {
+			return actionwait.FetchResult[*databrew.DescribeJobRunOutput]{}, err
+		}
+		return actionwait.FetchResult[*databrew.DescribeJobRunOutput]{Status: actionwait.Status(output.State), Value: output}, nil
+	}, actionwait.Options[*databrew.DescribeJobRunOutput]{
+		Timeout:          timeout,
+		Interval:         actionwait.WithBackoffDelay(backoff.DefaultSDKv2HelperRetryCompatibleDelay()),
+		ProgressInterval: 2 * time.Minute,
+		SuccessStates:    []actionwait.Status{actionwait.Status(awstypes.JobRunStateSucceeded)},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.JobRunStateStarting),
+			actionwait.Status(awstypes.JobRunStateRunning),
+			actionwait.Status(awstypes.JobRunStateStopping),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.JobRunStateFailed),
+			actionwait.Status(awstypes.JobRunStateStopped),
+			actionwait.Status(awstypes.JobRunStateTimeout),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			cb(ctx, "Job run currently in state: %s", fr.Status)
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError("Job run timeout", "DataBrew job run "+runID+" did not complete within the specified timeout")
+		} else if errors.As(err, &failureErr) {
+			detail := err.Error()
+			if fr.Value != nil && fr.Value.ErrorMessage != nil {
+				detail += ": " + aws.ToString(fr.Value.ErrorMessage)
+			}
+			resp.Diagnostics.AddError("Job run failed", "DataBrew job run "+runID+" completed with state: "+detail)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError("Unexpected job run state", err.Error())
+		} else {
+			resp.Diagnostics.AddError("Error waiting for job run", err.Error())
+		}
+		return
+	}
+
+	cb(ctx, "Job run %s completed successfully", runID)
+}
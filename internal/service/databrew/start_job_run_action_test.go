@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/databrew"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/databrew/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDataBrewStartJobRunAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckProfileJobDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartJobRunActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobRunSucceeded(ctx, t, rName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckJobRunSucceeded(ctx context.Context, t *testing.T, jobName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).DataBrewClient(ctx)
+
+		input := databrew.ListJobRunsInput{
+			Name: aws.String(jobName),
+		}
+		output, err := conn.ListJobRuns(ctx, &input)
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range output.JobRuns {
+			if v.State == awstypes.JobRunStateSucceeded {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("DataBrew Job %s has no successful runs", jobName)
+	}
+}
+
+func testAccStartJobRunActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccProfileJobConfig_basic(rName), `
+resource "aws_iam_role_policy" "test" {
+  role = aws_iam_role.test.name
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = [
+        "s3:GetObject",
+        "s3:ListBucket",
+        "s3:PutObject",
+      ]
+      Resource = [
+        aws_s3_bucket.test.arn,
+        "${aws_s3_bucket.test.arn}/*",
+      ]
+    }]
+  })
+}
+
+action "aws_databrew_start_job_run" "test" {
+  config {
+    job_name = aws_databrew_profile_job.test.name
+  }
+}
+
+resource "terraform_data" "trigger" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_databrew_start_job_run.test]
+    }
+  }
+
+  depends_on = [aws_databrew_profile_job.test, aws_iam_role_policy.test]
+}
+`)
+}
@@ -0,0 +1,174 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/databrew"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/databrew/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	awsv2.Register("aws_databrew_dataset", sweepDatasets, "aws_databrew_profile_job", "aws_databrew_project", "aws_databrew_recipe_job", "aws_databrew_ruleset")
+	awsv2.Register("aws_databrew_profile_job", sweepProfileJobs, "aws_databrew_schedule")
+	awsv2.Register("aws_databrew_project", sweepProjects, "aws_databrew_recipe_job")
+	awsv2.Register("aws_databrew_recipe", sweepRecipes, "aws_databrew_project", "aws_databrew_recipe_job")
+	awsv2.Register("aws_databrew_recipe_job", sweepRecipeJobs, "aws_databrew_schedule")
+	awsv2.Register("aws_databrew_ruleset", sweepRulesets, "aws_databrew_profile_job")
+	awsv2.Register("aws_databrew_schedule", sweepSchedules)
+}
+
+func sweepDatasets(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.DataBrewClient(ctx)
+	var input databrew.ListDatasetsInput
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := databrew.NewListDatasetsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Datasets {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newDatasetResource, client,
+				framework.NewAttribute(names.AttrName, aws.ToString(v.Name))))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepProfileJobs(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	return sweepJobs(ctx, client, awstypes.JobTypeProfile, newProfileJobResource)
+}
+
+func sweepRecipeJobs(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	return sweepJobs(ctx, client, awstypes.JobTypeRecipe, newRecipeJobResource)
+}
+
+func sweepJobs(ctx context.Context, client *conns.AWSClient, jobType awstypes.JobType, factory func(context.Context) (resource.ResourceWithConfigure, error)) ([]sweep.Sweepable, error) {
+	conn := client.DataBrewClient(ctx)
+	var input databrew.ListJobsInput
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := databrew.NewListJobsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Jobs {
+			if v.Type != jobType {
+				continue
+			}
+
+			sweepResources = append(sweepResources, framework.NewSweepResource(factory, client,
+				framework.NewAttribute(names.AttrName, aws.ToString(v.Name))))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepProjects(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.DataBrewClient(ctx)
+	var input databrew.ListProjectsInput
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := databrew.NewListProjectsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Projects {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newProjectResource, client,
+				framework.NewAttribute(names.AttrName, aws.ToString(v.Name))))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepRecipes(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.DataBrewClient(ctx)
+	input := databrew.ListRecipesInput{
+		RecipeVersion: aws.String(recipeVersionLatestWorking),
+	}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := databrew.NewListRecipesPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Recipes {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newRecipeResource, client,
+				framework.NewAttribute(names.AttrName, aws.ToString(v.Name))))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepRulesets(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.DataBrewClient(ctx)
+	var input databrew.ListRulesetsInput
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := databrew.NewListRulesetsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Rulesets {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newRulesetResource, client,
+				framework.NewAttribute(names.AttrName, aws.ToString(v.Name))))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepSchedules(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.DataBrewClient(ctx)
+	var input databrew.ListSchedulesInput
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := databrew.NewListSchedulesPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Schedules {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newScheduleResource, client,
+				framework.NewAttribute(names.AttrName, aws.ToString(v.Name))))
+		}
+	}
+
+	return sweepResources, nil
+}
@@ -0,0 +1,43 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_databrew_dataset" "test" {
+  region = var.region
+
+  name   = var.rName
+  format = "CSV"
+
+  input {
+    s3_input_definition {
+      bucket = aws_s3_object.test.bucket
+      key    = aws_s3_object.test.key
+    }
+  }
+}
+
+resource "aws_s3_bucket" "test" {
+  region = var.region
+
+  bucket        = var.rName
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  region = var.region
+
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "input/data.csv"
+  content = "id,name\n1,alpha\n2,beta\n"
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "region" {
+  description = "Region to deploy resource in"
+  type        = string
+  nullable    = false
+}
@@ -0,0 +1,603 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package evs
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/evs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/evs/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_evs_environment", name="Environment")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("environment_id")
+// @Testing(hasNoPreExistingResource=true)
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/evs/types;awstypes;awstypes.Environment")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(requireEnvVarValue="EVS_SITE_ID")
+// @Testing(requireEnvVarValue="EVS_VCF_SOLUTION_KEY")
+// @Testing(requireEnvVarValue="EVS_VSAN_LICENSE_KEY")
+// @Testing(randomBgpAsn="64512;65534")
+// @Testing(randomSSHPublicKey=true)
+// @Testing(identityRegionOverrideTest=false)
+// @Testing(importStateIdAttribute="environment_id")
+// @Testing(importIgnore="host;initial_vlans")
+func newEnvironmentResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &environmentResource{}
+
+	r.SetDefaultCreateTimeout(6 * time.Hour)
+	r.SetDefaultDeleteTimeout(6 * time.Hour)
+
+	return r, nil
+}
+
+type environmentResource struct {
+	framework.ResourceWithModel[environmentResourceModel]
+	framework.WithTimeouts
+	framework.WithImportByIdentity
+}
+
+func (r *environmentResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	initialVlanBlock := func(ctx context.Context) schema.Block {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[initialVlanInfoModel](ctx),
+			Validators: []validator.List{
+				listvalidator.IsRequired(),
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"cidr": schema.StringAttribute{
+						CustomType: fwtypes.CIDRBlockType,
+						Required:   true,
+					},
+				},
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"environment_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_name": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"environment_state": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EnvironmentState](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrKMSKeyID: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_access_subnet_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"site_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"terms_accepted": schema.BoolAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"vcf_version": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.VcfVersion](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrVPCID: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"connectivity_info": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[connectivityInfoModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"private_route_server_peerings": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Required:    true,
+							Validators: []validator.List{
+								listvalidator.SizeBetween(2, 2),
+							},
+						},
+					},
+				},
+			},
+			"host": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[hostInfoForCreateModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeBetween(4, 16),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"dedicated_host_id": schema.StringAttribute{
+							Optional: true,
+						},
+						"host_name": schema.StringAttribute{
+							Required: true,
+						},
+						names.AttrInstanceType: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.InstanceType](),
+							Required:   true,
+						},
+						"key_name": schema.StringAttribute{
+							Required: true,
+						},
+						"placement_group_id": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			"initial_vlans": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[initialVlansModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"hcx_network_acl_id": schema.StringAttribute{
+							Optional: true,
+						},
+						"is_hcx_public": schema.BoolAttribute{
+							Optional: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"edge_vtep":        initialVlanBlock(ctx),
+						"expansion_vlan_1": initialVlanBlock(ctx),
+						"expansion_vlan_2": initialVlanBlock(ctx),
+						"hcx":              initialVlanBlock(ctx),
+						"nsx_uplink":       initialVlanBlock(ctx),
+						"vmk_management":   initialVlanBlock(ctx),
+						"vm_management":    initialVlanBlock(ctx),
+						"vmotion":          initialVlanBlock(ctx),
+						"vsan":             initialVlanBlock(ctx),
+						"vtep":             initialVlanBlock(ctx),
+					},
+				},
+			},
+			"license_info": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[licenseInfoModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"solution_key": schema.StringAttribute{
+							Required:  true,
+							Sensitive: true,
+						},
+						"vsan_key": schema.StringAttribute{
+							Required:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+			"service_access_security_groups": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[serviceAccessSecurityGroupsModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrSecurityGroups: schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+			"vcf_hostnames": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[vcfHostnamesModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"cloud_builder": schema.StringAttribute{
+							Required: true,
+						},
+						"nsx": schema.StringAttribute{
+							Required: true,
+						},
+						"nsx_edge_1": schema.StringAttribute{
+							Required: true,
+						},
+						"nsx_edge_2": schema.StringAttribute{
+							Required: true,
+						},
+						"nsx_manager_1": schema.StringAttribute{
+							Required: true,
+						},
+						"nsx_manager_2": schema.StringAttribute{
+							Required: true,
+						},
+						"nsx_manager_3": schema.StringAttribute{
+							Required: true,
+						},
+						"sddc_manager": schema.StringAttribute{
+							Required: true,
+						},
+						"vcenter": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *environmentResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan environmentResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EVSClient(ctx)
+
+	var input evs.CreateEnvironmentInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, plan, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateEnvironment(ctx, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err)
+		return
+	}
+
+	id := aws.ToString(output.Environment.EnvironmentId)
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.SetAttribute(ctx, path.Root("environment_id"), id))
+
+	environment, err := waitEnvironmentCreated(ctx, conn, id, r.CreateTimeout(ctx, plan.Timeouts))
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, environment, &plan, fwflex.WithFieldNamePrefix("Environment")))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, plan))
+}
+
+func (r *environmentResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data environmentResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EVSClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.EnvironmentID)
+	output, err := findEnvironmentByID(ctx, conn, id)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &data, fwflex.WithFieldNamePrefix("Environment")))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *environmentResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data environmentResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EVSClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.EnvironmentID)
+	input := evs.DeleteEnvironmentInput{
+		EnvironmentId: aws.String(id),
+	}
+	_, err := conn.DeleteEnvironment(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	if _, err := waitEnvironmentDeleted(ctx, conn, id, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+}
+
+func findEnvironmentByID(ctx context.Context, conn *evs.Client, id string) (*awstypes.Environment, error) {
+	input := evs.GetEnvironmentInput{
+		EnvironmentId: aws.String(id),
+	}
+	output, err := findEnvironment(ctx, conn, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if state := output.EnvironmentState; state == awstypes.EnvironmentStateDeleted {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			Message: string(state),
+		})
+	}
+
+	return output, nil
+}
+
+func findEnvironment(ctx context.Context, conn *evs.Client, input *evs.GetEnvironmentInput) (*awstypes.Environment, error) {
+	output, err := conn.GetEnvironment(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil || output.Environment == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError())
+	}
+
+	return output.Environment, nil
+}
+
+func statusEnvironment(conn *evs.Client, id string) retry.StateRefreshFunc {
+	return func(ctx context.Context) (any, string, error) {
+		output, err := findEnvironmentByID(ctx, conn, id)
+
+		if retry.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.EnvironmentState), nil
+	}
+}
+
+func waitEnvironmentCreated(ctx context.Context, conn *evs.Client, id string, timeout time.Duration) (*awstypes.Environment, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:      enum.Slice(awstypes.EnvironmentStateCreating),
+		Target:       enum.Slice(awstypes.EnvironmentStateCreated),
+		Refresh:      statusEnvironment(conn, id),
+		Timeout:      timeout,
+		Delay:        5 * time.Minute,
+		PollInterval: 1 * time.Minute,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Environment); ok {
+		if output.StateDetails != nil {
+			retry.SetLastError(err, errors.New(aws.ToString(output.StateDetails)))
+		}
+
+		return output, smarterr.NewError(err)
+	}
+
+	return nil, smarterr.NewError(err)
+}
+
+func waitEnvironmentDeleted(ctx context.Context, conn *evs.Client, id string, timeout time.Duration) (*awstypes.Environment, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:      enum.Slice(awstypes.EnvironmentStateCreated, awstypes.EnvironmentStateCreateFailed, awstypes.EnvironmentStateDeleting),
+		Target:       []string{},
+		Refresh:      statusEnvironment(conn, id),
+		Timeout:      timeout,
+		Delay:        5 * time.Minute,
+		PollInterval: 1 * time.Minute,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Environment); ok {
+		if output.StateDetails != nil {
+			retry.SetLastError(err, errors.New(aws.ToString(output.StateDetails)))
+		}
+
+		return output, smarterr.NewError(err)
+	}
+
+	return nil, smarterr.NewError(err)
+}
+
+type environmentResourceModel struct {
+	framework.WithRegionModel
+	ARN                         types.String                                                      `tfsdk:"arn"`
+	ConnectivityInfo            fwtypes.ListNestedObjectValueOf[connectivityInfoModel]            `tfsdk:"connectivity_info"`
+	EnvironmentID               types.String                                                      `tfsdk:"environment_id"`
+	EnvironmentName             types.String                                                      `tfsdk:"environment_name"`
+	EnvironmentState            fwtypes.StringEnum[awstypes.EnvironmentState]                     `tfsdk:"environment_state"`
+	Hosts                       fwtypes.ListNestedObjectValueOf[hostInfoForCreateModel]           `tfsdk:"host"`
+	InitialVlans                fwtypes.ListNestedObjectValueOf[initialVlansModel]                `tfsdk:"initial_vlans"`
+	KMSKeyID                    types.String                                                      `tfsdk:"kms_key_id"`
+	LicenseInfo                 fwtypes.ListNestedObjectValueOf[licenseInfoModel]                 `tfsdk:"license_info"`
+	ServiceAccessSecurityGroups fwtypes.ListNestedObjectValueOf[serviceAccessSecurityGroupsModel] `tfsdk:"service_access_security_groups"`
+	ServiceAccessSubnetID       types.String                                                      `tfsdk:"service_access_subnet_id"`
+	SiteID                      types.String                                                      `tfsdk:"site_id"`
+	Tags                        tftags.Map                                                        `tfsdk:"tags"`
+	TagsAll                     tftags.Map                                                        `tfsdk:"tags_all"`
+	TermsAccepted               types.Bool                                                        `tfsdk:"terms_accepted"`
+	Timeouts                    timeouts.Value                                                    `tfsdk:"timeouts"`
+	VCFHostnames                fwtypes.ListNestedObjectValueOf[vcfHostnamesModel]                `tfsdk:"vcf_hostnames"`
+	VCFVersion                  fwtypes.StringEnum[awstypes.VcfVersion]                           `tfsdk:"vcf_version"`
+	VPCID                       types.String                                                      `tfsdk:"vpc_id"`
+}
+
+type connectivityInfoModel struct {
+	PrivateRouteServerPeerings fwtypes.ListOfString `tfsdk:"private_route_server_peerings"`
+}
+
+type hostInfoForCreateModel struct {
+	DedicatedHostID  types.String                              `tfsdk:"dedicated_host_id"`
+	HostName         types.String                              `tfsdk:"host_name"`
+	InstanceType     fwtypes.StringEnum[awstypes.InstanceType] `tfsdk:"instance_type"`
+	KeyName          types.String                              `tfsdk:"key_name"`
+	PlacementGroupID types.String                              `tfsdk:"placement_group_id"`
+}
+
+type initialVlansModel struct {
+	EdgeVTep        fwtypes.ListNestedObjectValueOf[initialVlanInfoModel] `tfsdk:"edge_vtep"`
+	ExpansionVlan1  fwtypes.ListNestedObjectValueOf[initialVlanInfoModel] `tfsdk:"expansion_vlan_1"`
+	ExpansionVlan2  fwtypes.ListNestedObjectValueOf[initialVlanInfoModel] `tfsdk:"expansion_vlan_2"`
+	HCX             fwtypes.ListNestedObjectValueOf[initialVlanInfoModel] `tfsdk:"hcx"`
+	HCXNetworkACLID types.String                                          `tfsdk:"hcx_network_acl_id"`
+	IsHCXPublic     types.Bool                                            `tfsdk:"is_hcx_public"`
+	NSXUplink       fwtypes.ListNestedObjectValueOf[initialVlanInfoModel] `tfsdk:"nsx_uplink"`
+	VMkManagement   fwtypes.ListNestedObjectValueOf[initialVlanInfoModel] `tfsdk:"vmk_management"`
+	VMManagement    fwtypes.ListNestedObjectValueOf[initialVlanInfoModel] `tfsdk:"vm_management"`
+	VMotion         fwtypes.ListNestedObjectValueOf[initialVlanInfoModel] `tfsdk:"vmotion"`
+	VSan            fwtypes.ListNestedObjectValueOf[initialVlanInfoModel] `tfsdk:"vsan"`
+	VTep            fwtypes.ListNestedObjectValueOf[initialVlanInfoModel] `tfsdk:"vtep"`
+}
+
+type initialVlanInfoModel struct {
+	CIDR fwtypes.CIDRBlock `tfsdk:"cidr"`
+}
+
+type licenseInfoModel struct {
+	SolutionKey types.String `tfsdk:"solution_key"`
+	VSANKey     types.String `tfsdk:"vsan_key"`
+}
+
+type serviceAccessSecurityGroupsModel struct {
+	SecurityGroups fwtypes.SetOfString `tfsdk:"security_groups"`
+}
+
+type vcfHostnamesModel struct {
+	CloudBuilder types.String `tfsdk:"cloud_builder"`
+	NSX          types.String `tfsdk:"nsx"`
+	NSXEdge1     types.String `tfsdk:"nsx_edge_1"`
+	NSXEdge2     types.String `tfsdk:"nsx_edge_2"`
+	NSXManager1  types.String `tfsdk:"nsx_manager_1"`
+	NSXManager2  types.String `tfsdk:"nsx_manager_2"`
+	NSXManager3  types.String `tfsdk:"nsx_manager_3"`
+	SDDCManager  types.String `tfsdk:"sddc_manager"`
+	VCenter      types.String `tfsdk:"vcenter"`
+}
@@ -0,0 +1,408 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package evs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/evs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/evs/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_evs_environment_host", name="Environment Host")
+// @IdentityAttribute("environment_id")
+// @IdentityAttribute("host_name")
+// @ImportIDHandler("environmentHostImportID")
+// @Testing(hasNoPreExistingResource=true)
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/evs/types;awstypes;awstypes.Host")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(requireEnvVarValue="EVS_SITE_ID")
+// @Testing(requireEnvVarValue="EVS_VCF_SOLUTION_KEY")
+// @Testing(requireEnvVarValue="EVS_VSAN_LICENSE_KEY")
+// @Testing(randomBgpAsn="64512;65534")
+// @Testing(randomSSHPublicKey=true)
+// @Testing(identityRegionOverrideTest=false)
+// @Testing(importStateIdAttributes="environment_id;host_name", importStateIdAttributesSep="flex.ResourceIdSeparator")
+func newEnvironmentHostResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &environmentHostResource{}
+
+	r.SetDefaultCreateTimeout(2 * time.Hour)
+	r.SetDefaultDeleteTimeout(2 * time.Hour)
+
+	return r, nil
+}
+
+type environmentHostResource struct {
+	framework.ResourceWithModel[environmentHostResourceModel]
+	framework.WithTimeouts
+	framework.WithImportByIdentity
+}
+
+func (r *environmentHostResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"dedicated_host_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ec2_instance_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"esx_version": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"host_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"host_state": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.HostState](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrInstanceType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.InstanceType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrIPAddress: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"placement_group_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *environmentHostResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan environmentHostResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EVSClient(ctx)
+
+	environmentID, hostName := fwflex.StringValueFromFramework(ctx, plan.EnvironmentID), fwflex.StringValueFromFramework(ctx, plan.HostName)
+	var host awstypes.HostInfoForCreate
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, plan, &host))
+	if response.Diagnostics.HasError() {
+		return
+	}
+	input := evs.CreateEnvironmentHostInput{
+		EnvironmentId: aws.String(environmentID),
+		EsxVersion:    fwflex.StringFromFramework(ctx, plan.ESXVersion),
+		Host:          &host,
+	}
+
+	_, err := conn.CreateEnvironmentHost(ctx, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, hostName)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.SetAttribute(ctx, path.Root("environment_id"), environmentID))
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.SetAttribute(ctx, path.Root("host_name"), hostName))
+
+	output, err := waitEnvironmentHostCreated(ctx, conn, environmentID, hostName, r.CreateTimeout(ctx, plan.Timeouts))
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, hostName)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, plan))
+}
+
+func (r *environmentHostResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data environmentHostResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EVSClient(ctx)
+
+	environmentID, hostName := fwflex.StringValueFromFramework(ctx, data.EnvironmentID), fwflex.StringValueFromFramework(ctx, data.HostName)
+	output, err := findEnvironmentHostByTwoPartKey(ctx, conn, environmentID, hostName)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, hostName)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *environmentHostResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data environmentHostResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EVSClient(ctx)
+
+	environmentID, hostName := fwflex.StringValueFromFramework(ctx, data.EnvironmentID), fwflex.StringValueFromFramework(ctx, data.HostName)
+	input := evs.DeleteEnvironmentHostInput{
+		EnvironmentId: aws.String(environmentID),
+		HostName:      aws.String(hostName),
+	}
+	_, err := conn.DeleteEnvironmentHost(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, hostName)
+		return
+	}
+
+	if _, err := waitEnvironmentHostDeleted(ctx, conn, environmentID, hostName, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, hostName)
+		return
+	}
+}
+
+var (
+	_ inttypes.ImportIDParser = environmentHostImportID{}
+)
+
+type environmentHostImportID struct{}
+
+func (environmentHostImportID) Parse(id string) (string, map[string]any, error) {
+	environmentID, hostName, found := strings.Cut(id, intflex.ResourceIdSeparator)
+	if !found {
+		return "", nil, fmt.Errorf("id %q should be in the format <environment-id>%s<host-name>", id, intflex.ResourceIdSeparator)
+	}
+
+	result := map[string]any{
+		"environment_id": environmentID,
+		"host_name":      hostName,
+	}
+
+	return id, result, nil
+}
+
+func findEnvironmentHostByTwoPartKey(ctx context.Context, conn *evs.Client, environmentID, hostName string) (*awstypes.Host, error) {
+	input := evs.ListEnvironmentHostsInput{
+		EnvironmentId: aws.String(environmentID),
+	}
+	output, err := findEnvironmentHost(ctx, conn, &input, func(v *awstypes.Host) bool {
+		return aws.ToString(v.HostName) == hostName
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if state := output.HostState; state == awstypes.HostStateDeleted {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			Message: string(state),
+		})
+	}
+
+	return output, nil
+}
+
+func findEnvironmentHost(ctx context.Context, conn *evs.Client, input *evs.ListEnvironmentHostsInput, filter tfslices.Predicate[*awstypes.Host]) (*awstypes.Host, error) {
+	output, err := findEnvironmentHosts(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return smarterr.Assert(tfresource.AssertSingleValueResult(output))
+}
+
+func findEnvironmentHosts(ctx context.Context, conn *evs.Client, input *evs.ListEnvironmentHostsInput, filter tfslices.Predicate[*awstypes.Host]) ([]awstypes.Host, error) {
+	var output []awstypes.Host
+
+	pages := evs.NewListEnvironmentHostsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, smarterr.NewError(&retry.NotFoundError{
+				LastError: err,
+			})
+		}
+
+		if err != nil {
+			return nil, smarterr.NewError(err)
+		}
+
+		for _, v := range page.EnvironmentHosts {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+func statusEnvironmentHost(conn *evs.Client, environmentID, hostName string) retry.StateRefreshFunc {
+	return func(ctx context.Context) (any, string, error) {
+		output, err := findEnvironmentHostByTwoPartKey(ctx, conn, environmentID, hostName)
+
+		if retry.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.HostState), nil
+	}
+}
+
+func waitEnvironmentHostCreated(ctx context.Context, conn *evs.Client, environmentID, hostName string, timeout time.Duration) (*awstypes.Host, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:      enum.Slice(awstypes.HostStateCreating),
+		Target:       enum.Slice(awstypes.HostStateCreated),
+		Refresh:      statusEnvironmentHost(conn, environmentID, hostName),
+		Timeout:      timeout,
+		Delay:        1 * time.Minute,
+		PollInterval: 30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Host); ok {
+		if output.StateDetails != nil {
+			retry.SetLastError(err, errors.New(aws.ToString(output.StateDetails)))
+		}
+
+		return output, smarterr.NewError(err)
+	}
+
+	return nil, smarterr.NewError(err)
+}
+
+func waitEnvironmentHostDeleted(ctx context.Context, conn *evs.Client, environmentID, hostName string, timeout time.Duration) (*awstypes.Host, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:      enum.Slice(awstypes.HostStateCreated, awstypes.HostStateCreateFailed, awstypes.HostStateUpdateFailed, awstypes.HostStateDeleting),
+		Target:       []string{},
+		Refresh:      statusEnvironmentHost(conn, environmentID, hostName),
+		Timeout:      timeout,
+		Delay:        1 * time.Minute,
+		PollInterval: 30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Host); ok {
+		if output.StateDetails != nil {
+			retry.SetLastError(err, errors.New(aws.ToString(output.StateDetails)))
+		}
+
+		return output, smarterr.NewError(err)
+	}
+
+	return nil, smarterr.NewError(err)
+}
+
+type environmentHostResourceModel struct {
+	framework.WithRegionModel
+	DedicatedHostID  types.String                              `tfsdk:"dedicated_host_id"`
+	EC2InstanceID    types.String                              `tfsdk:"ec2_instance_id"`
+	EnvironmentID    types.String                              `tfsdk:"environment_id"`
+	ESXVersion       types.String                              `tfsdk:"esx_version"`
+	HostName         types.String                              `tfsdk:"host_name"`
+	HostState        fwtypes.StringEnum[awstypes.HostState]    `tfsdk:"host_state"`
+	InstanceType     fwtypes.StringEnum[awstypes.InstanceType] `tfsdk:"instance_type"`
+	IPAddress        types.String                              `tfsdk:"ip_address"`
+	KeyName          types.String                              `tfsdk:"key_name"`
+	PlacementGroupID types.String                              `tfsdk:"placement_group_id"`
+	Timeouts         timeouts.Value                            `tfsdk:"timeouts"`
+}
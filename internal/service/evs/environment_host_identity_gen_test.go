@@ -0,0 +1,147 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/identitytests/main.go; DO NOT EDIT.
+
+package evs_test
+
+import (
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/evs/types"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfknownvalue "github.com/hashicorp/terraform-provider-aws/internal/acctest/knownvalue"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEVSEnvironmentHost_Identity_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var v awstypes.Host
+	acctest.SkipIfEnvVarNotSet(t, "EVS_SITE_ID")
+	acctest.SkipIfEnvVarNotSet(t, "EVS_VCF_SOLUTION_KEY")
+	acctest.SkipIfEnvVarNotSet(t, "EVS_VSAN_LICENSE_KEY")
+	resourceName := "aws_evs_environment_host.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	rBgpAsn := acctest.RandIntRange(t, 64512, 65534)
+	rSSHPublicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EVSServiceID),
+		CheckDestroy:             testAccCheckEnvironmentHostDestroy(ctx, t),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			// Step 1: Setup
+			{
+				ConfigDirectory: config.StaticDirectory("testdata/EnvironmentHost/basic/"),
+				ConfigVariables: config.Variables{
+					acctest.CtRName:        config.StringVariable(rName),
+					"rBgpAsn":              config.IntegerVariable(rBgpAsn),
+					"rSSHPublicKey":        config.StringVariable(rSSHPublicKey),
+					"EVS_SITE_ID":          config.StringVariable(acctest.SkipIfEnvVarNotSet(t, "EVS_SITE_ID")),
+					"EVS_VCF_SOLUTION_KEY": config.StringVariable(acctest.SkipIfEnvVarNotSet(t, "EVS_VCF_SOLUTION_KEY")),
+					"EVS_VSAN_LICENSE_KEY": config.StringVariable(acctest.SkipIfEnvVarNotSet(t, "EVS_VSAN_LICENSE_KEY")),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEnvironmentHostExists(ctx, t, resourceName, &v),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.Region())),
+					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAccountID: tfknownvalue.AccountID(),
+						names.AttrRegion:    knownvalue.StringExact(acctest.Region()),
+						"environment_id":    knownvalue.NotNull(),
+						"host_name":         knownvalue.NotNull(),
+					}),
+					statecheck.ExpectIdentityValueMatchesState(resourceName, tfjsonpath.New("environment_id")),
+					statecheck.ExpectIdentityValueMatchesState(resourceName, tfjsonpath.New("host_name")),
+				},
+			},
+
+			// Step 2: Import command
+			{
+				ConfigDirectory: config.StaticDirectory("testdata/EnvironmentHost/basic/"),
+				ConfigVariables: config.Variables{
+					acctest.CtRName:        config.StringVariable(rName),
+					"rBgpAsn":              config.IntegerVariable(rBgpAsn),
+					"rSSHPublicKey":        config.StringVariable(rSSHPublicKey),
+					"EVS_SITE_ID":          config.StringVariable(acctest.SkipIfEnvVarNotSet(t, "EVS_SITE_ID")),
+					"EVS_VCF_SOLUTION_KEY": config.StringVariable(acctest.SkipIfEnvVarNotSet(t, "EVS_VCF_SOLUTION_KEY")),
+					"EVS_VSAN_LICENSE_KEY": config.StringVariable(acctest.SkipIfEnvVarNotSet(t, "EVS_VSAN_LICENSE_KEY")),
+				},
+				ImportStateKind:                      resource.ImportCommandWithID,
+				ImportStateIdFunc:                    acctest.AttrsImportStateIdFunc(resourceName, flex.ResourceIdSeparator, "environment_id", "host_name"),
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "environment_id",
+			},
+
+			// Step 3: Import block with Import ID
+			{
+				ConfigDirectory: config.StaticDirectory("testdata/EnvironmentHost/basic/"),
+				ConfigVariables: config.Variables{
+					acctest.CtRName:        config.StringVariable(rName),
+					"rBgpAsn":              config.IntegerVariable(rBgpAsn),
+					"rSSHPublicKey":        config.StringVariable(rSSHPublicKey),
+					"EVS_SITE_ID":          config.StringVariable(acctest.SkipIfEnvVarNotSet(t, "EVS_SITE_ID")),
+					"EVS_VCF_SOLUTION_KEY": config.StringVariable(acctest.SkipIfEnvVarNotSet(t, "EVS_VCF_SOLUTION_KEY")),
+					"EVS_VSAN_LICENSE_KEY": config.StringVariable(acctest.SkipIfEnvVarNotSet(t, "EVS_VSAN_LICENSE_KEY")),
+				},
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateKind:   resource.ImportBlockWithID,
+				ImportStateIdFunc: acctest.AttrsImportStateIdFunc(resourceName, flex.ResourceIdSeparator, "environment_id", "host_name"),
+				ImportPlanChecks: resource.ImportPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("environment_id"), knownvalue.NotNull()),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("host_name"), knownvalue.NotNull()),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.Region())),
+					},
+				},
+			},
+
+			// Step 4: Import block with Resource Identity
+			{
+				ConfigDirectory: config.StaticDirectory("testdata/EnvironmentHost/basic/"),
+				ConfigVariables: config.Variables{
+					acctest.CtRName:        config.StringVariable(rName),
+					"rBgpAsn":              config.IntegerVariable(rBgpAsn),
+					"rSSHPublicKey":        config.StringVariable(rSSHPublicKey),
+					"EVS_SITE_ID":          config.StringVariable(acctest.SkipIfEnvVarNotSet(t, "EVS_SITE_ID")),
+					"EVS_VCF_SOLUTION_KEY": config.StringVariable(acctest.SkipIfEnvVarNotSet(t, "EVS_VCF_SOLUTION_KEY")),
+					"EVS_VSAN_LICENSE_KEY": config.StringVariable(acctest.SkipIfEnvVarNotSet(t, "EVS_VSAN_LICENSE_KEY")),
+				},
+				ResourceName:    resourceName,
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+				ImportPlanChecks: resource.ImportPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("environment_id"), knownvalue.NotNull()),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("host_name"), knownvalue.NotNull()),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.Region())),
+					},
+				},
+			},
+		},
+	})
+}
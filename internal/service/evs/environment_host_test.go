@@ -0,0 +1,175 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package evs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/evs/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfknownvalue "github.com/hashicorp/terraform-provider-aws/internal/acctest/knownvalue"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfevs "github.com/hashicorp/terraform-provider-aws/internal/service/evs"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEVSEnvironmentHost_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	siteID := acctest.SkipIfEnvVarNotSet(t, envVarSiteID)
+	solutionKey := acctest.SkipIfEnvVarNotSet(t, envVarSolutionKey)
+	vsanKey := acctest.SkipIfEnvVarNotSet(t, envVarVSANKey)
+	var v awstypes.Host
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	rAsn := acctest.RandIntRange(t, 64512, 65534)
+	resourceName := "aws_evs_environment_host.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EVSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentHostDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentHostConfig_basic(rName, rAsn, publicKey, siteID, solutionKey, vsanKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEnvironmentHostExists(ctx, t, resourceName, &v),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("ec2_instance_id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("host_name"), knownvalue.StringExact("esx-4")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("host_state"), tfknownvalue.StringExact(awstypes.HostStateCreated)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrInstanceType), tfknownvalue.StringExact(awstypes.InstanceTypeI4iMetal)),
+				},
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateIdFunc:                    acctest.AttrsImportStateIdFunc(resourceName, ",", "environment_id", "host_name"),
+				ImportStateVerifyIdentifierAttribute: "host_name",
+			},
+		},
+	})
+}
+
+func TestAccEVSEnvironmentHost_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	siteID := acctest.SkipIfEnvVarNotSet(t, envVarSiteID)
+	solutionKey := acctest.SkipIfEnvVarNotSet(t, envVarSolutionKey)
+	vsanKey := acctest.SkipIfEnvVarNotSet(t, envVarVSANKey)
+	var v awstypes.Host
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	rAsn := acctest.RandIntRange(t, 64512, 65534)
+	resourceName := "aws_evs_environment_host.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EVSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentHostDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentHostConfig_basic(rName, rAsn, publicKey, siteID, solutionKey, vsanKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEnvironmentHostExists(ctx, t, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, t, tfevs.ResourceEnvironmentHost, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckEnvironmentHostDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).EVSClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_evs_environment_host" {
+				continue
+			}
+
+			_, err := tfevs.FindEnvironmentHostByTwoPartKey(ctx, conn, rs.Primary.Attributes["environment_id"], rs.Primary.Attributes["host_name"])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("EVS Environment Host %s still exists", rs.Primary.Attributes["host_name"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckEnvironmentHostExists(ctx context.Context, t *testing.T, n string, v *awstypes.Host) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).EVSClient(ctx)
+
+		output, err := tfevs.FindEnvironmentHostByTwoPartKey(ctx, conn, rs.Primary.Attributes["environment_id"], rs.Primary.Attributes["host_name"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccEnvironmentHostConfig_basic(rName string, rAsn int, publicKey, siteID, solutionKey, vsanKey string) string {
+	return acctest.ConfigCompose(testAccEnvironmentConfig_basic(rName, rAsn, publicKey, siteID, solutionKey, vsanKey), `
+resource "aws_evs_environment_host" "test" {
+  environment_id = aws_evs_environment.test.environment_id
+  host_name      = "esx-4"
+  instance_type  = "i4i.metal"
+  key_name       = aws_key_pair.test.key_name
+}
+`)
+}
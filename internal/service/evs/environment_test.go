@@ -0,0 +1,334 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package evs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/evs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/evs/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfknownvalue "github.com/hashicorp/terraform-provider-aws/internal/acctest/knownvalue"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfevs "github.com/hashicorp/terraform-provider-aws/internal/service/evs"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Amazon EVS environments take several hours to create and require a VCF license and a
+// pre-registered site, so these tests only run when the following environment variables are set.
+const (
+	envVarSiteID      = "EVS_SITE_ID"
+	envVarSolutionKey = "EVS_VCF_SOLUTION_KEY"
+	envVarVSANKey     = "EVS_VSAN_LICENSE_KEY"
+)
+
+func TestAccEVSEnvironment_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	siteID := acctest.SkipIfEnvVarNotSet(t, envVarSiteID)
+	solutionKey := acctest.SkipIfEnvVarNotSet(t, envVarSolutionKey)
+	vsanKey := acctest.SkipIfEnvVarNotSet(t, envVarVSANKey)
+	var v awstypes.Environment
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	rAsn := acctest.RandIntRange(t, 64512, 65534)
+	resourceName := "aws_evs_environment.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EVSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentConfig_basic(rName, rAsn, publicKey, siteID, solutionKey, vsanKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, t, resourceName, &v),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrARN), tfknownvalue.RegionalARNRegexp("evs", regexache.MustCompile(`environment/.+$`))),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("environment_id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("environment_name"), knownvalue.StringExact(rName)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("environment_state"), tfknownvalue.StringExact(awstypes.EnvironmentStateCreated)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrKMSKeyID), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("vcf_version"), tfknownvalue.StringExact(awstypes.VcfVersionVcf522)),
+				},
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "environment_id"),
+				ImportStateVerifyIdentifierAttribute: "environment_id",
+				ImportStateVerifyIgnore: []string{
+					"host",
+					"initial_vlans",
+				},
+			},
+		},
+	})
+}
+
+func TestAccEVSEnvironment_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	siteID := acctest.SkipIfEnvVarNotSet(t, envVarSiteID)
+	solutionKey := acctest.SkipIfEnvVarNotSet(t, envVarSolutionKey)
+	vsanKey := acctest.SkipIfEnvVarNotSet(t, envVarVSANKey)
+	var v awstypes.Environment
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	rAsn := acctest.RandIntRange(t, 64512, 65534)
+	resourceName := "aws_evs_environment.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EVSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentConfig_basic(rName, rAsn, publicKey, siteID, solutionKey, vsanKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, t, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, t, tfevs.ResourceEnvironment, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckEnvironmentDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).EVSClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_evs_environment" {
+				continue
+			}
+
+			_, err := tfevs.FindEnvironmentByID(ctx, conn, rs.Primary.Attributes["environment_id"])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("EVS Environment %s still exists", rs.Primary.Attributes["environment_id"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckEnvironmentExists(ctx context.Context, t *testing.T, n string, v *awstypes.Environment) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).EVSClient(ctx)
+
+		output, err := tfevs.FindEnvironmentByID(ctx, conn, rs.Primary.Attributes["environment_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.ProviderMeta(ctx, t).EVSClient(ctx)
+
+	var input evs.ListEnvironmentsInput
+	_, err := conn.ListEnvironments(ctx, &input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccEnvironmentConfig_base(rName string, rAsn int, publicKey string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptInDefaultExclude(), fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  vpc_id            = aws_vpc.test.id
+  availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = "10.0.0.0/24"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_key_pair" "test" {
+  key_name   = %[1]q
+  public_key = %[3]q
+}
+
+resource "aws_vpc_route_server" "test" {
+  amazon_side_asn = %[2]d
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_route_server_vpc_association" "test" {
+  route_server_id = aws_vpc_route_server.test.route_server_id
+  vpc_id          = aws_vpc.test.id
+}
+
+resource "aws_vpc_route_server_endpoint" "test" {
+  count = 2
+
+  route_server_id = aws_vpc_route_server_vpc_association.test.route_server_id
+  subnet_id       = aws_subnet.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_route_server_peer" "test" {
+  count = 2
+
+  route_server_endpoint_id = aws_vpc_route_server_endpoint.test[count.index].route_server_endpoint_id
+  peer_address             = "10.0.5.${count.index + 10}"
+
+  bgp_options {
+    peer_asn = 65000
+  }
+}
+`, rName, rAsn, publicKey))
+}
+
+func testAccEnvironmentConfig_basic(rName string, rAsn int, publicKey, siteID, solutionKey, vsanKey string) string {
+	return acctest.ConfigCompose(testAccEnvironmentConfig_base(rName, rAsn, publicKey), fmt.Sprintf(`
+resource "aws_evs_environment" "test" {
+  environment_name         = %[1]q
+  service_access_subnet_id = aws_subnet.test.id
+  site_id                  = %[2]q
+  terms_accepted           = true
+  vcf_version              = "VCF-5.2.2"
+  vpc_id                   = aws_vpc.test.id
+
+  connectivity_info {
+    private_route_server_peerings = aws_vpc_route_server_peer.test[*].route_server_peer_id
+  }
+
+  dynamic "host" {
+    for_each = range(4)
+
+    content {
+      host_name     = "esx-${host.value}"
+      instance_type = "i4i.metal"
+      key_name      = aws_key_pair.test.key_name
+    }
+  }
+
+  initial_vlans {
+    vmk_management {
+      cidr = "10.0.1.0/24"
+    }
+    vm_management {
+      cidr = "10.0.2.0/24"
+    }
+    vmotion {
+      cidr = "10.0.3.0/24"
+    }
+    vsan {
+      cidr = "10.0.4.0/24"
+    }
+    vtep {
+      cidr = "10.0.5.0/24"
+    }
+    edge_vtep {
+      cidr = "10.0.6.0/24"
+    }
+    nsx_uplink {
+      cidr = "10.0.7.0/24"
+    }
+    hcx {
+      cidr = "10.0.8.0/24"
+    }
+    expansion_vlan_1 {
+      cidr = "10.0.9.0/24"
+    }
+    expansion_vlan_2 {
+      cidr = "10.0.10.0/24"
+    }
+  }
+
+  license_info {
+    solution_key = %[3]q
+    vsan_key     = %[4]q
+  }
+
+  vcf_hostnames {
+    cloud_builder = "cb"
+    nsx           = "nsx"
+    nsx_edge_1    = "edge1"
+    nsx_edge_2    = "edge2"
+    nsx_manager_1 = "nsxm1"
+    nsx_manager_2 = "nsxm2"
+    nsx_manager_3 = "nsxm3"
+    sddc_manager  = "sddcm"
+    vcenter       = "vc"
+  }
+}
+`, rName, siteID, solutionKey, vsanKey))
+}
@@ -0,0 +1,13 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package evs
+
+// Exports for use in tests only.
+var (
+	ResourceEnvironment     = newEnvironmentResource
+	ResourceEnvironmentHost = newEnvironmentHostResource
+
+	FindEnvironmentByID             = findEnvironmentByID
+	FindEnvironmentHostByTwoPartKey = findEnvironmentHostByTwoPartKey
+)
@@ -3,4 +3,44 @@
 
 package evs
 
-func RegisterSweepers() {}
+import (
+	"context"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/evs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/evs/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+)
+
+func RegisterSweepers() {
+	awsv2.Register("aws_evs_environment", sweepEnvironments)
+}
+
+func sweepEnvironments(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.EVSClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	input := evs.ListEnvironmentsInput{
+		State: []awstypes.EnvironmentState{awstypes.EnvironmentStateCreated, awstypes.EnvironmentStateCreateFailed},
+	}
+	pages := evs.NewListEnvironmentsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, smarterr.NewError(err)
+		}
+
+		for _, v := range page.EnvironmentSummaries {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newEnvironmentResource, client,
+				framework.NewAttribute("environment_id", aws.ToString(v.EnvironmentId)),
+			))
+		}
+	}
+
+	return sweepResources, nil
+}
@@ -0,0 +1,174 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_evs_environment" "test" {
+  environment_name         = var.rName
+  service_access_subnet_id = aws_subnet.test.id
+  site_id                  = var.EVS_SITE_ID
+  terms_accepted           = true
+  vcf_version              = "VCF-5.2.2"
+  vpc_id                   = aws_vpc.test.id
+
+  connectivity_info {
+    private_route_server_peerings = aws_vpc_route_server_peer.test[*].route_server_peer_id
+  }
+
+  dynamic "host" {
+    for_each = range(4)
+
+    content {
+      host_name     = "esx-${host.value}"
+      instance_type = "i4i.metal"
+      key_name      = aws_key_pair.test.key_name
+    }
+  }
+
+  initial_vlans {
+    vmk_management {
+      cidr = "10.0.1.0/24"
+    }
+    vm_management {
+      cidr = "10.0.2.0/24"
+    }
+    vmotion {
+      cidr = "10.0.3.0/24"
+    }
+    vsan {
+      cidr = "10.0.4.0/24"
+    }
+    vtep {
+      cidr = "10.0.5.0/24"
+    }
+    edge_vtep {
+      cidr = "10.0.6.0/24"
+    }
+    nsx_uplink {
+      cidr = "10.0.7.0/24"
+    }
+    hcx {
+      cidr = "10.0.8.0/24"
+    }
+    expansion_vlan_1 {
+      cidr = "10.0.9.0/24"
+    }
+    expansion_vlan_2 {
+      cidr = "10.0.10.0/24"
+    }
+  }
+
+  license_info {
+    solution_key = var.EVS_VCF_SOLUTION_KEY
+    vsan_key     = var.EVS_VSAN_LICENSE_KEY
+  }
+
+  vcf_hostnames {
+    cloud_builder = "cb"
+    nsx           = "nsx"
+    nsx_edge_1    = "edge1"
+    nsx_edge_2    = "edge2"
+    nsx_manager_1 = "nsxm1"
+    nsx_manager_2 = "nsxm2"
+    nsx_manager_3 = "nsxm3"
+    sddc_manager  = "sddcm"
+    vcenter       = "vc"
+  }
+
+  tags = var.resource_tags
+}
+
+# acctest.ConfigAvailableAZsNoOptInDefaultExclude
+
+data "aws_availability_zones" "available" {
+  exclude_zone_ids = local.default_exclude_zone_ids
+  state            = "available"
+
+  filter {
+    name   = "opt-in-status"
+    values = ["opt-in-not-required"]
+  }
+}
+
+locals {
+  default_exclude_zone_ids = ["usw2-az4", "usgw1-az2"]
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = var.rName
+  }
+}
+
+resource "aws_subnet" "test" {
+  vpc_id            = aws_vpc.test.id
+  availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = "10.0.0.0/24"
+
+  tags = {
+    Name = var.rName
+  }
+}
+
+resource "aws_key_pair" "test" {
+  key_name   = var.rName
+  public_key = var.rSSHPublicKey
+}
+
+resource "aws_vpc_route_server" "test" {
+  amazon_side_asn = var.rBgpAsn
+
+  tags = {
+    Name = var.rName
+  }
+}
+
+resource "aws_vpc_route_server_vpc_association" "test" {
+  route_server_id = aws_vpc_route_server.test.route_server_id
+  vpc_id          = aws_vpc.test.id
+}
+
+resource "aws_vpc_route_server_endpoint" "test" {
+  count = 2
+
+  route_server_id = aws_vpc_route_server_vpc_association.test.route_server_id
+  subnet_id       = aws_subnet.test.id
+
+  tags = {
+    Name = var.rName
+  }
+}
+
+resource "aws_vpc_route_server_peer" "test" {
+  count = 2
+
+  route_server_endpoint_id = aws_vpc_route_server_endpoint.test[count.index].route_server_endpoint_id
+  peer_address             = "10.0.5.${count.index + 10}"
+
+  bgp_options {
+    peer_asn = 65000
+  }
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "rBgpAsn" {
+  type     = string
+  nullable = false
+}
+
+variable "rSSHPublicKey" {
+  type     = string
+  nullable = false
+}
+
+variable "resource_tags" {
+  description = "Tags to set on resource. To specify no tags, set to `null`"
+  # Not setting a default, so that this must explicitly be set to `null` to specify no tags
+  type     = map(string)
+  nullable = true
+}
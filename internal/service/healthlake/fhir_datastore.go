@@ -0,0 +1,483 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package healthlake
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/healthlake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/healthlake/types"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_healthlake_fhir_datastore", name="FHIR Datastore")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/healthlake/types;awstypes;awstypes.DatastoreProperties")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(hasNoPreExistingResource=true)
+func newFHIRDatastoreResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &fhirDatastoreResource{}
+
+	// Data store creation routinely takes well over an hour.
+	r.SetDefaultCreateTimeout(4 * time.Hour)
+	r.SetDefaultDeleteTimeout(2 * time.Hour)
+
+	return r, nil
+}
+
+type fhirDatastoreResource struct {
+	framework.ResourceWithModel[fhirDatastoreResourceModel]
+	framework.WithTimeouts
+	framework.WithImportByIdentity
+}
+
+func (r *fhirDatastoreResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"datastore_type_version": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.FHIRVersion](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrEndpoint: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"identity_provider_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[identityProviderConfigurationModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"authorization_strategy": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.AuthorizationStrategy](),
+							Required:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+						"fine_grained_authorization_enabled": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Bool{
+								boolplanmodifier.RequiresReplace(),
+								boolplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"idp_lambda_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Optional:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+						"metadata": schema.StringAttribute{
+							CustomType: jsontypes.NormalizedType{},
+							Optional:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+					},
+				},
+			},
+			"preload_data_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[preloadDataConfigModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"preload_data_type": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.PreloadDataType](),
+							Required:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+					},
+				},
+			},
+			"sse_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[sseConfigurationModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"kms_encryption_config": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[kmsEncryptionConfigModel](ctx),
+							PlanModifiers: []planmodifier.List{
+								listplanmodifier.RequiresReplace(),
+							},
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"cmk_type": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.CmkType](),
+										Required:   true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.RequiresReplace(),
+										},
+									},
+									names.AttrKMSKeyID: schema.StringAttribute{
+										Optional: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.RequiresReplace(),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *fhirDatastoreResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan fhirDatastoreResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().HealthLakeClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, plan.Name)
+	var input healthlake.CreateFHIRDatastoreInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, plan, &input, fwflex.WithFieldNamePrefix("Datastore")))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateFHIRDatastore(ctx, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	id := aws.ToString(output.DatastoreId)
+	datastore, err := waitFHIRDatastoreCreated(ctx, conn, id, r.CreateTimeout(ctx, plan.Timeouts))
+
+	if err != nil {
+		smerr.AddEnrich(ctx, &response.Diagnostics, response.State.SetAttribute(ctx, path.Root(names.AttrID), id))
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, flattenFHIRDatastore(ctx, datastore, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, plan))
+}
+
+func (r *fhirDatastoreResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data fhirDatastoreResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().HealthLakeClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.ID)
+	output, err := findFHIRDatastoreByID(ctx, conn, id)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, flattenFHIRDatastore(ctx, output, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *fhirDatastoreResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data fhirDatastoreResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().HealthLakeClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.ID)
+	input := healthlake.DeleteFHIRDatastoreInput{
+		DatastoreId: aws.String(id),
+	}
+	_, err := conn.DeleteFHIRDatastore(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	if _, err := waitFHIRDatastoreDeleted(ctx, conn, id, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+}
+
+// flattenFHIRDatastore flattens the data store's properties into the resource model.
+// HealthLake reports its default encryption and authorization settings even when none were configured,
+// so those defaults are only kept in state if the corresponding block was already present.
+func flattenFHIRDatastore(ctx context.Context, apiObject *awstypes.DatastoreProperties, data *fhirDatastoreResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	identityProviderConfigurationNull, sseConfigurationNull := data.IdentityProviderConfiguration.IsNull(), data.SSEConfiguration.IsNull()
+
+	diags.Append(fwflex.Flatten(ctx, apiObject, data, fwflex.WithFieldNamePrefix("Datastore"))...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if v := apiObject.IdentityProviderConfiguration; identityProviderConfigurationNull && v != nil &&
+		v.AuthorizationStrategy == awstypes.AuthorizationStrategyAwsAuth && !v.FineGrainedAuthorizationEnabled && v.IdpLambdaArn == nil && v.Metadata == nil {
+		data.IdentityProviderConfiguration = fwtypes.NewListNestedObjectValueOfNull[identityProviderConfigurationModel](ctx)
+	}
+
+	if v := apiObject.SseConfiguration; sseConfigurationNull && v != nil &&
+		(v.KmsEncryptionConfig == nil || v.KmsEncryptionConfig.CmkType == awstypes.CmkTypeAoCmk) {
+		data.SSEConfiguration = fwtypes.NewListNestedObjectValueOfNull[sseConfigurationModel](ctx)
+	}
+
+	return diags
+}
+
+func findFHIRDatastoreByID(ctx context.Context, conn *healthlake.Client, id string) (*awstypes.DatastoreProperties, error) {
+	input := healthlake.DescribeFHIRDatastoreInput{
+		DatastoreId: aws.String(id),
+	}
+	output, err := findFHIRDatastore(ctx, conn, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.DatastoreStatus == awstypes.DatastoreStatusDeleted {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			Message: string(output.DatastoreStatus),
+		})
+	}
+
+	return output, nil
+}
+
+func findFHIRDatastore(ctx context.Context, conn *healthlake.Client, input *healthlake.DescribeFHIRDatastoreInput) (*awstypes.DatastoreProperties, error) {
+	output, err := conn.DescribeFHIRDatastore(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil || output.DatastoreProperties == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError())
+	}
+
+	return output.DatastoreProperties, nil
+}
+
+func statusFHIRDatastore(conn *healthlake.Client, id string) retry.StateRefreshFunc {
+	return func(ctx context.Context) (any, string, error) {
+		output, err := findFHIRDatastoreByID(ctx, conn, id)
+
+		if retry.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.DatastoreStatus), nil
+	}
+}
+
+func waitFHIRDatastoreCreated(ctx context.Context, conn *healthlake.Client, id string, timeout time.Duration) (*awstypes.DatastoreProperties, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:      enum.Slice(awstypes.DatastoreStatusCreating),
+		Target:       enum.Slice(awstypes.DatastoreStatusActive),
+		Refresh:      statusFHIRDatastore(conn, id),
+		Timeout:      timeout,
+		Delay:        5 * time.Minute,
+		PollInterval: 1 * time.Minute,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DatastoreProperties); ok {
+		if v := output.ErrorCause; v != nil {
+			retry.SetLastError(err, errors.New(aws.ToString(v.ErrorMessage)))
+		}
+
+		return output, smarterr.NewError(err)
+	}
+
+	return nil, smarterr.NewError(err)
+}
+
+func waitFHIRDatastoreDeleted(ctx context.Context, conn *healthlake.Client, id string, timeout time.Duration) (*awstypes.DatastoreProperties, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:      enum.Slice(awstypes.DatastoreStatusDeleting, awstypes.DatastoreStatusActive),
+		Target:       []string{},
+		Refresh:      statusFHIRDatastore(conn, id),
+		Timeout:      timeout,
+		Delay:        1 * time.Minute,
+		PollInterval: 30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DatastoreProperties); ok {
+		if v := output.ErrorCause; v != nil {
+			retry.SetLastError(err, errors.New(aws.ToString(v.ErrorMessage)))
+		}
+
+		return output, smarterr.NewError(err)
+	}
+
+	return nil, smarterr.NewError(err)
+}
+
+type fhirDatastoreResourceModel struct {
+	framework.WithRegionModel
+	ARN                           types.String                                                        `tfsdk:"arn"`
+	CreatedAt                     timetypes.RFC3339                                                   `tfsdk:"created_at"`
+	DatastoreTypeVersion          fwtypes.StringEnum[awstypes.FHIRVersion]                            `tfsdk:"datastore_type_version"`
+	Endpoint                      types.String                                                        `tfsdk:"endpoint"`
+	ID                            types.String                                                        `tfsdk:"id"`
+	IdentityProviderConfiguration fwtypes.ListNestedObjectValueOf[identityProviderConfigurationModel] `tfsdk:"identity_provider_configuration"`
+	Name                          types.String                                                        `tfsdk:"name"`
+	PreloadDataConfig             fwtypes.ListNestedObjectValueOf[preloadDataConfigModel]             `tfsdk:"preload_data_config"`
+	SSEConfiguration              fwtypes.ListNestedObjectValueOf[sseConfigurationModel]              `tfsdk:"sse_configuration"`
+	Tags                          tftags.Map                                                          `tfsdk:"tags"`
+	TagsAll                       tftags.Map                                                          `tfsdk:"tags_all"`
+	Timeouts                      timeouts.Value                                                      `tfsdk:"timeouts"`
+}
+
+type identityProviderConfigurationModel struct {
+	AuthorizationStrategy           fwtypes.StringEnum[awstypes.AuthorizationStrategy] `tfsdk:"authorization_strategy"`
+	FineGrainedAuthorizationEnabled types.Bool                                         `tfsdk:"fine_grained_authorization_enabled"`
+	IdpLambdaARN                    fwtypes.ARN                                        `tfsdk:"idp_lambda_arn"`
+	Metadata                        jsontypes.Normalized                               `tfsdk:"metadata"`
+}
+
+type preloadDataConfigModel struct {
+	PreloadDataType fwtypes.StringEnum[awstypes.PreloadDataType] `tfsdk:"preload_data_type"`
+}
+
+type sseConfigurationModel struct {
+	KMSEncryptionConfig fwtypes.ListNestedObjectValueOf[kmsEncryptionConfigModel] `tfsdk:"kms_encryption_config"`
+}
+
+type kmsEncryptionConfigModel struct {
+	CMKType  fwtypes.StringEnum[awstypes.CmkType] `tfsdk:"cmk_type"`
+	KMSKeyID types.String                         `tfsdk:"kms_key_id"`
+}
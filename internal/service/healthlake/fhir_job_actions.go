@@ -0,0 +1,127 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package healthlake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/healthlake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/healthlake/types"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	// fhirJobActionPollInterval defines polling cadence for the FHIR import and export job actions.
+	fhirJobActionPollInterval = 30 * time.Second
+	// fhirJobActionProgressInterval defines how often progress is reported while a FHIR job runs.
+	fhirJobActionProgressInterval = 1 * time.Minute
+	// fhirJobActionDefaultTimeout is used when no timeout is configured.
+	fhirJobActionDefaultTimeout = 1 * time.Hour
+)
+
+var (
+	fhirJobSuccessStates = []actionwait.Status{
+		actionwait.Status(awstypes.JobStatusCompleted),
+		actionwait.Status(awstypes.JobStatusCompletedWithErrors),
+	}
+	fhirJobTransitionalStates = []actionwait.Status{
+		actionwait.Status(awstypes.JobStatusSubmitted),
+		actionwait.Status(awstypes.JobStatusQueued),
+		actionwait.Status(awstypes.JobStatusInProgress),
+	}
+	fhirJobFailureStates = []actionwait.Status{
+		actionwait.Status(awstypes.JobStatusFailed),
+		actionwait.Status(awstypes.JobStatusCancelSubmitted),
+		actionwait.Status(awstypes.JobStatusCancelInProgress),
+		actionwait.Status(awstypes.JobStatusCancelCompleted),
+		actionwait.Status(awstypes.JobStatusCancelFailed),
+	}
+)
+
+func findFHIRImportJobByTwoPartKey(ctx context.Context, conn *healthlake.Client, datastoreID, jobID string) (*awstypes.ImportJobProperties, error) {
+	input := healthlake.DescribeFHIRImportJobInput{
+		DatastoreId: aws.String(datastoreID),
+		JobId:       aws.String(jobID),
+	}
+	output, err := conn.DescribeFHIRImportJob(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil || output.ImportJobProperties == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError())
+	}
+
+	return output.ImportJobProperties, nil
+}
+
+func findFHIRExportJobByTwoPartKey(ctx context.Context, conn *healthlake.Client, datastoreID, jobID string) (*awstypes.ExportJobProperties, error) {
+	input := healthlake.DescribeFHIRExportJobInput{
+		DatastoreId: aws.String(datastoreID),
+		JobId:       aws.String(jobID),
+	}
+	output, err := conn.DescribeFHIRExportJob(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil || output.ExportJobProperties == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError())
+	}
+
+	return output.ExportJobProperties, nil
+}
+
+// addFHIRJobActionWaitError converts an actionwait error into a diagnostic.
+func addFHIRJobActionWaitError(diags *diag.Diagnostics, err error, jobType, jobID, message string, timeout time.Duration) {
+	var timeoutErr *actionwait.TimeoutError
+	var failureErr *actionwait.FailureStateError
+	var unexpectedErr *actionwait.UnexpectedStateError
+
+	if errors.As(err, &timeoutErr) {
+		diags.AddError(
+			fmt.Sprintf("Timeout Waiting for FHIR %s Job", jobType),
+			fmt.Sprintf("FHIR %s job %s did not complete within %s", jobType, jobID, timeout),
+		)
+	} else if errors.As(err, &failureErr) {
+		detail := fmt.Sprintf("FHIR %s job %s finished with status %s", jobType, jobID, failureErr.Status)
+		if message != "" {
+			detail += ": " + message
+		}
+		diags.AddError(fmt.Sprintf("FHIR %s Job Failed", jobType), detail)
+	} else if errors.As(err, &unexpectedErr) {
+		diags.AddError(
+			fmt.Sprintf("Unexpected FHIR %s Job Status", jobType),
+			fmt.Sprintf("FHIR %s job %s entered unexpected status: %s", jobType, jobID, unexpectedErr.Status),
+		)
+	} else {
+		diags.AddError(
+			fmt.Sprintf("Error Waiting for FHIR %s Job", jobType),
+			fmt.Sprintf("Error while waiting for FHIR %s job %s: %s", jobType, jobID, err),
+		)
+	}
+}
@@ -0,0 +1,190 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package healthlake
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/healthlake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/healthlake/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_healthlake_start_fhir_export_job, name="Start FHIR Export Job")
+func newStartFHIRExportJobAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &startFHIRExportJobAction{}, nil
+}
+
+var (
+	_ action.Action = (*startFHIRExportJobAction)(nil)
+)
+
+type startFHIRExportJobAction struct {
+	framework.ActionWithModel[startFHIRExportJobActionModel]
+}
+
+type startFHIRExportJobActionModel struct {
+	framework.WithRegionModel
+	DataAccessRoleARN fwtypes.ARN  `tfsdk:"data_access_role_arn"`
+	DatastoreID       types.String `tfsdk:"datastore_id"`
+	JobName           types.String `tfsdk:"job_name"`
+	OutputKMSKeyID    types.String `tfsdk:"output_kms_key_id"`
+	OutputS3URI       types.String `tfsdk:"output_s3_uri"`
+	Timeout           types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *startFHIRExportJobAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts an AWS HealthLake FHIR export job that writes the FHIR resources of a data store to Amazon S3. This action waits for the export job to complete, reporting progress while it runs.",
+		Attributes: map[string]schema.Attribute{
+			"data_access_role_arn": schema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Description: "The ARN of the IAM role that grants HealthLake access to the output S3 location",
+				Required:    true,
+			},
+			"datastore_id": schema.StringAttribute{
+				Description: "The ID of the data store to export from",
+				Required:    true,
+			},
+			"job_name": schema.StringAttribute{
+				Description: "A name for the export job",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"output_kms_key_id": schema.StringAttribute{
+				Description: "The ID or ARN of the KMS key used to encrypt the exported files",
+				Required:    true,
+			},
+			"output_s3_uri": schema.StringAttribute{
+				Description: "The S3 URI to which the FHIR resources are exported",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(1024),
+				},
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the export job to complete (default: 3600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(86400),
+				},
+			},
+		},
+	}
+}
+
+func (a *startFHIRExportJobAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startFHIRExportJobActionModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get AWS client
+	conn := a.Meta().HealthLakeClient(ctx)
+
+	datastoreID := fwflex.StringValueFromFramework(ctx, config.DatastoreID)
+	outputS3URI := fwflex.StringValueFromFramework(ctx, config.OutputS3URI)
+
+	// Set default timeout if not provided
+	timeout := fwactions.TimeoutOr(config.Timeout, fhirJobActionDefaultTimeout)
+
+	tflog.Info(ctx, "Starting HealthLake start FHIR export job action", map[string]any{
+		"datastore_id":    datastoreID,
+		"output_s3_uri":   outputS3URI,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	// Send initial progress update
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Starting FHIR export job for data store %s to %s...", datastoreID, outputS3URI)
+
+	input := healthlake.StartFHIRExportJobInput{
+		ClientToken:       aws.String(sdkid.UniqueId()),
+		DataAccessRoleArn: fwflex.StringFromFramework(ctx, config.DataAccessRoleARN),
+		DatastoreId:       aws.String(datastoreID),
+		JobName:           fwflex.StringFromFramework(ctx, config.JobName),
+		OutputDataConfig: &awstypes.OutputDataConfigMemberS3Configuration{
+			Value: awstypes.S3Configuration{
+				KmsKeyId: fwflex.StringFromFramework(ctx, config.OutputKMSKeyID),
+				S3Uri:    aws.String(outputS3URI),
+			},
+		},
+	}
+
+	output, err := conn.StartFHIRExportJob(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Start FHIR Export Job",
+			fmt.Sprintf("Could not start FHIR export job for data store %s: %s", datastoreID, err),
+		)
+		return
+	}
+
+	jobID := aws.ToString(output.JobId)
+
+	cb(ctx, "FHIR export job %s started, waiting for it to complete...", jobID)
+
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.ExportJobProperties], error) {
+		job, err := findFHIRExportJobByTwoPartKey(ctx, conn, datastoreID, jobID)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.ExportJobProperties]{}, fmt.Errorf("describing FHIR export job: %w", err)
+		}
+		return actionwait.FetchResult[*awstypes.ExportJobProperties]{Status: actionwait.Status(job.JobStatus), Value: job}, nil
+	}, actionwait.Options[*awstypes.ExportJobProperties]{
+		Timeout:            timeout,
+		Interval:           actionwait.FixedInterval(fhirJobActionPollInterval),
+		ProgressInterval:   fhirJobActionProgressInterval,
+		SuccessStates:      fhirJobSuccessStates,
+		TransitionalStates: fhirJobTransitionalStates,
+		FailureStates:      fhirJobFailureStates,
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			cb(ctx, "FHIR export job %s is currently %s", jobID, fr.Status)
+		},
+	})
+	if err != nil {
+		var message string
+		if fr.Value != nil {
+			message = aws.ToString(fr.Value.Message)
+		}
+		addFHIRJobActionWaitError(&resp.Diagnostics, err, "Export", jobID, message, timeout)
+		return
+	}
+
+	if fr.Status == actionwait.Status(awstypes.JobStatusCompletedWithErrors) {
+		resp.Diagnostics.AddWarning(
+			"FHIR Export Job Completed With Errors",
+			fmt.Sprintf("FHIR export job %s completed with errors: %s", jobID, aws.ToString(fr.Value.Message)),
+		)
+	}
+
+	// Final success message
+	cb(ctx, "FHIR export job %s finished with status %s, resources were exported to %s", jobID, fr.Status, outputS3URI)
+
+	tflog.Info(ctx, "HealthLake start FHIR export job action completed successfully", map[string]any{
+		"datastore_id": datastoreID,
+		"job_id":       jobID,
+		"job_status":   fr.Status,
+	})
+}
@@ -0,0 +1,104 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package healthlake_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/healthlake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/healthlake/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccHealthLakeStartFHIRExportJobAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_healthlake_fhir_datastore.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.HealthLakeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFHIRDatastoreDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartFHIRExportJobActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFHIRExportJobStatus(ctx, t, resourceName, rName, awstypes.JobStatusCompleted),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFHIRExportJobStatus(ctx context.Context, t *testing.T, n, jobName string, want awstypes.JobStatus) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).HealthLakeClient(ctx)
+
+		input := healthlake.ListFHIRExportJobsInput{
+			DatastoreId: aws.String(rs.Primary.ID),
+			JobName:     aws.String(jobName),
+		}
+		output, err := conn.ListFHIRExportJobs(ctx, &input)
+
+		if err != nil {
+			return err
+		}
+
+		if len(output.ExportJobPropertiesList) != 1 {
+			return fmt.Errorf("Expected 1 HealthLake FHIR export job named %s, got %d", jobName, len(output.ExportJobPropertiesList))
+		}
+
+		if got := output.ExportJobPropertiesList[0].JobStatus; got != want {
+			return fmt.Errorf("Expected HealthLake FHIR export job %s status %s, got %s", jobName, want, got)
+		}
+
+		return nil
+	}
+}
+
+func testAccStartFHIRExportJobActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccFHIRJobActionConfig_base(rName), fmt.Sprintf(`
+action "aws_healthlake_start_fhir_export_job" "test" {
+  config {
+    datastore_id         = aws_healthlake_fhir_datastore.test.id
+    data_access_role_arn = aws_iam_role.test.arn
+    job_name             = %[1]q
+    output_s3_uri        = "s3://${aws_s3_bucket.test.bucket}/export/"
+    output_kms_key_id    = aws_kms_key.test.arn
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_healthlake_start_fhir_export_job.test]
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
@@ -0,0 +1,214 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package healthlake
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/healthlake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/healthlake/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_healthlake_start_fhir_import_job, name="Start FHIR Import Job")
+func newStartFHIRImportJobAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &startFHIRImportJobAction{}, nil
+}
+
+var (
+	_ action.Action = (*startFHIRImportJobAction)(nil)
+)
+
+type startFHIRImportJobAction struct {
+	framework.ActionWithModel[startFHIRImportJobActionModel]
+}
+
+type startFHIRImportJobActionModel struct {
+	framework.WithRegionModel
+	DataAccessRoleARN fwtypes.ARN                                  `tfsdk:"data_access_role_arn"`
+	DatastoreID       types.String                                 `tfsdk:"datastore_id"`
+	InputS3URI        types.String                                 `tfsdk:"input_s3_uri"`
+	JobName           types.String                                 `tfsdk:"job_name"`
+	OutputKMSKeyID    types.String                                 `tfsdk:"output_kms_key_id"`
+	OutputS3URI       types.String                                 `tfsdk:"output_s3_uri"`
+	Timeout           types.Int64                                  `tfsdk:"timeout"`
+	ValidationLevel   fwtypes.StringEnum[awstypes.ValidationLevel] `tfsdk:"validation_level"`
+}
+
+func (a *startFHIRImportJobAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts an AWS HealthLake FHIR import job that loads FHIR resources from Amazon S3 into a data store. This action waits for the import job to complete, reporting progress while it runs.",
+		Attributes: map[string]schema.Attribute{
+			"data_access_role_arn": schema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Description: "The ARN of the IAM role that grants HealthLake access to the input and output S3 locations",
+				Required:    true,
+			},
+			"datastore_id": schema.StringAttribute{
+				Description: "The ID of the data store to import into",
+				Required:    true,
+			},
+			"input_s3_uri": schema.StringAttribute{
+				Description: "The S3 URI of the FHIR files to import (for example, s3://bucket/prefix/)",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(1024),
+				},
+			},
+			"job_name": schema.StringAttribute{
+				Description: "A name for the import job",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"output_kms_key_id": schema.StringAttribute{
+				Description: "The ID or ARN of the KMS key used to encrypt the import job's output logs",
+				Required:    true,
+			},
+			"output_s3_uri": schema.StringAttribute{
+				Description: "The S3 URI to which the import job writes its output logs",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(1024),
+				},
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the import job to complete (default: 3600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(86400),
+				},
+			},
+			"validation_level": schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.ValidationLevel](),
+				Description: "The validation level applied to imported resources",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (a *startFHIRImportJobAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startFHIRImportJobActionModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get AWS client
+	conn := a.Meta().HealthLakeClient(ctx)
+
+	datastoreID := fwflex.StringValueFromFramework(ctx, config.DatastoreID)
+	inputS3URI := fwflex.StringValueFromFramework(ctx, config.InputS3URI)
+
+	// Set default timeout if not provided
+	timeout := fwactions.TimeoutOr(config.Timeout, fhirJobActionDefaultTimeout)
+
+	tflog.Info(ctx, "Starting HealthLake start FHIR import job action", map[string]any{
+		"datastore_id":    datastoreID,
+		"input_s3_uri":    inputS3URI,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	// Send initial progress update
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Starting FHIR import job for data store %s from %s...", datastoreID, inputS3URI)
+
+	input := healthlake.StartFHIRImportJobInput{
+		ClientToken:       aws.String(sdkid.UniqueId()),
+		DataAccessRoleArn: fwflex.StringFromFramework(ctx, config.DataAccessRoleARN),
+		DatastoreId:       aws.String(datastoreID),
+		InputDataConfig: &awstypes.InputDataConfigMemberS3Uri{
+			Value: inputS3URI,
+		},
+		JobName: fwflex.StringFromFramework(ctx, config.JobName),
+		JobOutputDataConfig: &awstypes.OutputDataConfigMemberS3Configuration{
+			Value: awstypes.S3Configuration{
+				KmsKeyId: fwflex.StringFromFramework(ctx, config.OutputKMSKeyID),
+				S3Uri:    fwflex.StringFromFramework(ctx, config.OutputS3URI),
+			},
+		},
+		ValidationLevel: config.ValidationLevel.ValueEnum(),
+	}
+
+	output, err := conn.StartFHIRImportJob(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Start FHIR Import Job",
+			fmt.Sprintf("Could not start FHIR import job for data store %s: %s", datastoreID, err),
+		)
+		return
+	}
+
+	jobID := aws.ToString(output.JobId)
+
+	cb(ctx, "FHIR import job %s started, waiting for it to complete...", jobID)
+
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.ImportJobProperties], error) {
+		job, err := findFHIRImportJobByTwoPartKey(ctx, conn, datastoreID, jobID)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.ImportJobProperties]{}, fmt.Errorf("describing FHIR import job: %w", err)
+		}
+		return actionwait.FetchResult[*awstypes.ImportJobProperties]{Status: actionwait.Status(job.JobStatus), Value: job}, nil
+	}, actionwait.Options[*awstypes.ImportJobProperties]{
+		Timeout:            timeout,
+		Interval:           actionwait.FixedInterval(fhirJobActionPollInterval),
+		ProgressInterval:   fhirJobActionProgressInterval,
+		SuccessStates:      fhirJobSuccessStates,
+		TransitionalStates: fhirJobTransitionalStates,
+		FailureStates:      fhirJobFailureStates,
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			if job, ok := fr.Value.(*awstypes.ImportJobProperties); ok && job.JobProgressReport != nil {
+				report := job.JobProgressReport
+				cb(ctx, "FHIR import job %s is currently %s: %d of %d scanned resources imported", jobID, fr.Status,
+					aws.ToInt64(report.TotalNumberOfResourcesImported), aws.ToInt64(report.TotalNumberOfResourcesScanned))
+				return
+			}
+			cb(ctx, "FHIR import job %s is currently %s", jobID, fr.Status)
+		},
+	})
+	if err != nil {
+		var message string
+		if fr.Value != nil {
+			message = aws.ToString(fr.Value.Message)
+		}
+		addFHIRJobActionWaitError(&resp.Diagnostics, err, "Import", jobID, message, timeout)
+		return
+	}
+
+	if fr.Status == actionwait.Status(awstypes.JobStatusCompletedWithErrors) {
+		resp.Diagnostics.AddWarning(
+			"FHIR Import Job Completed With Errors",
+			fmt.Sprintf("FHIR import job %s completed with errors, see the job output in %s for details: %s", jobID, config.OutputS3URI.ValueString(), aws.ToString(fr.Value.Message)),
+		)
+	}
+
+	// Final success message
+	cb(ctx, "FHIR import job %s finished with status %s", jobID, fr.Status)
+
+	tflog.Info(ctx, "HealthLake start FHIR import job action completed successfully", map[string]any{
+		"datastore_id": datastoreID,
+		"job_id":       jobID,
+		"job_status":   fr.Status,
+	})
+}
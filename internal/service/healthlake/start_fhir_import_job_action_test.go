@@ -0,0 +1,182 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package healthlake_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/healthlake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/healthlake/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccHealthLakeStartFHIRImportJobAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_healthlake_fhir_datastore.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.HealthLakeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFHIRDatastoreDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartFHIRImportJobActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFHIRImportJobStatus(ctx, t, resourceName, rName, awstypes.JobStatusCompleted),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFHIRImportJobStatus(ctx context.Context, t *testing.T, n, jobName string, want awstypes.JobStatus) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).HealthLakeClient(ctx)
+
+		input := healthlake.ListFHIRImportJobsInput{
+			DatastoreId: aws.String(rs.Primary.ID),
+			JobName:     aws.String(jobName),
+		}
+		output, err := conn.ListFHIRImportJobs(ctx, &input)
+
+		if err != nil {
+			return err
+		}
+
+		if len(output.ImportJobPropertiesList) != 1 {
+			return fmt.Errorf("Expected 1 HealthLake FHIR import job named %s, got %d", jobName, len(output.ImportJobPropertiesList))
+		}
+
+		if got := output.ImportJobPropertiesList[0].JobStatus; got != want {
+			return fmt.Errorf("Expected HealthLake FHIR import job %s status %s, got %s", jobName, want, got)
+		}
+
+		return nil
+	}
+}
+
+// testAccFHIRJobActionConfig_base returns the configuration for a data store and the S3 bucket, KMS key and IAM role used by FHIR import and export jobs.
+func testAccFHIRJobActionConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_healthlake_fhir_datastore" "test" {
+  name                   = %[1]q
+  datastore_type_version = "R4"
+}
+
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+  enable_key_rotation     = true
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "healthlake.${data.aws_partition.current.dns_suffix}"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Effect   = "Allow"
+        Action   = ["s3:ListBucket", "s3:GetBucketPublicAccessBlock", "s3:GetEncryptionConfiguration"]
+        Resource = aws_s3_bucket.test.arn
+      },
+      {
+        Effect   = "Allow"
+        Action   = ["s3:GetObject", "s3:PutObject"]
+        Resource = "${aws_s3_bucket.test.arn}/*"
+      },
+      {
+        Effect   = "Allow"
+        Action   = ["kms:DescribeKey", "kms:GenerateDataKey", "kms:Decrypt"]
+        Resource = aws_kms_key.test.arn
+      },
+    ]
+  })
+}
+`, rName)
+}
+
+func testAccStartFHIRImportJobActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccFHIRJobActionConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_object" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  key    = "import/patient.ndjson"
+  content = jsonencode({
+    resourceType = "Patient"
+    id           = "example"
+    name = [{
+      family = "Example"
+      given  = ["Test"]
+    }]
+  })
+}
+
+action "aws_healthlake_start_fhir_import_job" "test" {
+  config {
+    datastore_id         = aws_healthlake_fhir_datastore.test.id
+    data_access_role_arn = aws_iam_role.test.arn
+    job_name             = %[1]q
+    input_s3_uri         = "s3://${aws_s3_bucket.test.bucket}/import/"
+    output_s3_uri        = "s3://${aws_s3_bucket.test.bucket}/output/"
+    output_kms_key_id    = aws_kms_key.test.arn
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_healthlake_start_fhir_import_job.test]
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test, aws_s3_object.test]
+}
+`, rName))
+}
@@ -69,14 +69,9 @@ var (
 	AttachPolicyToUser                = attachPolicyToUser
 	CheckPwdPolicy                    = checkPwdPolicy
 	GeneratePassword                  = generatePassword
-	IsValidPolicyAWSPrincipal         = isValidPolicyAWSPrincipal // nosemgrep:ci.aws-in-var-name
 	ListGroupsForUserPages            = listGroupsForUserPages
 	RoleNameSessionFromARN            = roleNameSessionFromARN
 	RolePolicyParseID                 = rolePolicyParseID
 	ServiceLinkedRoleParseResourceID  = serviceLinkedRoleParseResourceID
 	SESSMTPPasswordFromSecretKeySigV4 = sesSMTPPasswordFromSecretKeySigV4
 )
-
-type (
-	IAMPolicyStatementConditionSet = iamPolicyStatementConditionSet
-)
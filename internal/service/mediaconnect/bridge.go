@@ -0,0 +1,837 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect
+
+import (
+	"context"
+	"time"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediaconnect"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediaconnect/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_mediaconnect_bridge", name="Bridge")
+// @ArnIdentity
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/mediaconnect/types;awstypes;awstypes.Bridge")
+// @Testing(importStateIdAttribute="arn")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(hasNoPreExistingResource=true)
+func newBridgeResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &bridgeResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type bridgeResource struct {
+	framework.ResourceWithModel[bridgeResourceModel]
+	framework.WithTimeouts
+	framework.WithImportByIdentity
+}
+
+func (r *bridgeResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"placement_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"egress_gateway_bridge": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[egressGatewayBridgeModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_bitrate": schema.Int32Attribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"ingress_gateway_bridge": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[ingressGatewayBridgeModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_bitrate": schema.Int32Attribute{
+							Required: true,
+						},
+						"max_outputs": schema.Int32Attribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"output": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[bridgeOutputModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"network_output": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[bridgeNetworkOutputModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrIPAddress: schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrName: schema.StringAttribute{
+										Required: true,
+									},
+									"network_name": schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrPort: schema.Int32Attribute{
+										Required: true,
+									},
+									names.AttrProtocol: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.Protocol](),
+										Required:   true,
+									},
+									"ttl": schema.Int32Attribute{
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrSource: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[bridgeSourceModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"flow_source": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[bridgeFlowSourceModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("flow_source"),
+									path.MatchRelative().AtParent().AtName("network_source"),
+								),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"flow_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+									names.AttrName: schema.StringAttribute{
+										Required: true,
+									},
+									"output_arn": schema.StringAttribute{
+										Computed: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"flow_vpc_interface_attachment": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[vpcInterfaceAttachmentModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"vpc_interface_name": schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"network_source": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[bridgeNetworkSourceModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"multicast_ip": schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrName: schema.StringAttribute{
+										Required: true,
+									},
+									"network_name": schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrPort: schema.Int32Attribute{
+										Required: true,
+									},
+									names.AttrProtocol: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.Protocol](),
+										Required:   true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"multicast_source_settings": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[multicastSourceSettingsModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"multicast_source_ip": schema.StringAttribute{
+													Optional: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"source_failover_config": sourceFailoverConfigBlock(ctx),
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *bridgeResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan bridgeResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, plan.Name)
+	var input mediaconnect.CreateBridgeInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, plan, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateBridge(ctx, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	arn := aws.ToString(output.Bridge.BridgeArn)
+	bridge, err := waitBridgeCreated(ctx, conn, arn, r.CreateTimeout(ctx, plan.Timeouts))
+
+	if err != nil {
+		smerr.AddEnrich(ctx, &response.Diagnostics, response.State.SetAttribute(ctx, path.Root(names.AttrARN), arn))
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, flattenBridge(ctx, bridge, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, plan))
+}
+
+func (r *bridgeResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data bridgeResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	arn := fwflex.StringValueFromFramework(ctx, data.ARN)
+	output, err := findBridgeByARN(ctx, conn, arn)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, flattenBridge(ctx, output, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *bridgeResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old bridgeResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &new))
+	if response.Diagnostics.HasError() {
+		return
+	}
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &old))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	arn := fwflex.StringValueFromFramework(ctx, new.ARN)
+	timeout := r.UpdateTimeout(ctx, new.Timeouts)
+
+	if !new.EgressGatewayBridge.Equal(old.EgressGatewayBridge) || !new.IngressGatewayBridge.Equal(old.IngressGatewayBridge) || !new.SourceFailoverConfig.Equal(old.SourceFailoverConfig) {
+		var input mediaconnect.UpdateBridgeInput
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, new, &input))
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.BridgeArn = aws.String(arn)
+		if new.SourceFailoverConfig.IsNull() && !old.SourceFailoverConfig.IsNull() {
+			input.SourceFailoverConfig = &awstypes.UpdateFailoverConfig{
+				State: awstypes.StateDisabled,
+			}
+		}
+
+		_, err := conn.UpdateBridge(ctx, &input)
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+
+		if _, err := waitBridgeUpdated(ctx, conn, arn, timeout); err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+	}
+
+	// Sources and outputs are reconciled by name.
+	sourceName := func(m *bridgeSourceModel) string {
+		return m.name(ctx)
+	}
+	newSources, d := new.Sources.ToSlice(ctx)
+	smerr.AddEnrich(ctx, &response.Diagnostics, d)
+	oldSources, d := old.Sources.ToSlice(ctx)
+	smerr.AddEnrich(ctx, &response.Diagnostics, d)
+	outputName := func(m *bridgeOutputModel) string {
+		return m.name(ctx)
+	}
+	newOutputs, d := new.Outputs.ToSlice(ctx)
+	smerr.AddEnrich(ctx, &response.Diagnostics, d)
+	oldOutputs, d := old.Outputs.ToSlice(ctx)
+	smerr.AddEnrich(ctx, &response.Diagnostics, d)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var addSources []awstypes.AddBridgeSourceRequest
+	for _, v := range newSources {
+		name := sourceName(v)
+		o := findModelByName(oldSources, name, sourceName)
+
+		if o == nil {
+			var apiObject awstypes.AddBridgeSourceRequest
+			smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, v, &apiObject))
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			addSources = append(addSources, apiObject)
+			continue
+		}
+
+		diff, d := fwflex.Diff(ctx, v, o)
+		smerr.AddEnrich(ctx, &response.Diagnostics, d)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if !diff.HasChanges() {
+			continue
+		}
+
+		var input mediaconnect.UpdateBridgeSourceInput
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, v, &input))
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.BridgeArn = aws.String(arn)
+		input.SourceName = aws.String(name)
+
+		_, err := conn.UpdateBridgeSource(ctx, &input)
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+
+		if _, err := waitBridgeUpdated(ctx, conn, arn, timeout); err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+	}
+
+	if len(addSources) > 0 {
+		input := mediaconnect.AddBridgeSourcesInput{
+			BridgeArn: aws.String(arn),
+			Sources:   addSources,
+		}
+		_, err := conn.AddBridgeSources(ctx, &input)
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+
+		if _, err := waitBridgeUpdated(ctx, conn, arn, timeout); err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+	}
+
+	for _, v := range oldSources {
+		name := sourceName(v)
+		if findModelByName(newSources, name, sourceName) != nil {
+			continue
+		}
+
+		input := mediaconnect.RemoveBridgeSourceInput{
+			BridgeArn:  aws.String(arn),
+			SourceName: aws.String(name),
+		}
+		_, err := conn.RemoveBridgeSource(ctx, &input)
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+
+		if _, err := waitBridgeUpdated(ctx, conn, arn, timeout); err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+	}
+
+	for _, v := range oldOutputs {
+		name := outputName(v)
+		if findModelByName(newOutputs, name, outputName) != nil {
+			continue
+		}
+
+		input := mediaconnect.RemoveBridgeOutputInput{
+			BridgeArn:  aws.String(arn),
+			OutputName: aws.String(name),
+		}
+		_, err := conn.RemoveBridgeOutput(ctx, &input)
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+
+		if _, err := waitBridgeUpdated(ctx, conn, arn, timeout); err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+	}
+
+	var addOutputs []awstypes.AddBridgeOutputRequest
+	for _, v := range newOutputs {
+		name := outputName(v)
+		o := findModelByName(oldOutputs, name, outputName)
+
+		if o == nil {
+			var apiObject awstypes.AddBridgeOutputRequest
+			smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, v, &apiObject))
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			addOutputs = append(addOutputs, apiObject)
+			continue
+		}
+
+		diff, d := fwflex.Diff(ctx, v, o)
+		smerr.AddEnrich(ctx, &response.Diagnostics, d)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if !diff.HasChanges() {
+			continue
+		}
+
+		var input mediaconnect.UpdateBridgeOutputInput
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, v, &input))
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.BridgeArn = aws.String(arn)
+		input.OutputName = aws.String(name)
+
+		_, err := conn.UpdateBridgeOutput(ctx, &input)
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+
+		if _, err := waitBridgeUpdated(ctx, conn, arn, timeout); err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+	}
+
+	if len(addOutputs) > 0 {
+		input := mediaconnect.AddBridgeOutputsInput{
+			BridgeArn: aws.String(arn),
+			Outputs:   addOutputs,
+		}
+		_, err := conn.AddBridgeOutputs(ctx, &input)
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+	}
+
+	bridge, err := waitBridgeUpdated(ctx, conn, arn, timeout)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, flattenBridge(ctx, bridge, &new))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &new))
+}
+
+func (r *bridgeResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data bridgeResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	arn := fwflex.StringValueFromFramework(ctx, data.ARN)
+	input := mediaconnect.DeleteBridgeInput{
+		BridgeArn: aws.String(arn),
+	}
+	_, err := conn.DeleteBridge(ctx, &input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+		return
+	}
+
+	if _, err := waitBridgeDeleted(ctx, conn, arn, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+		return
+	}
+}
+
+// flattenBridge flattens the bridge into the resource model.
+// Sources and outputs are kept in the order in which they were configured.
+func flattenBridge(ctx context.Context, apiObject *awstypes.Bridge, data *bridgeResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	sourceFailoverConfigNull := data.SourceFailoverConfig.IsNull()
+	outputNames, d := modelNames(ctx, data.Outputs, func(m *bridgeOutputModel) string { return m.name(ctx) })
+	diags.Append(d...)
+	sourceNames, d := modelNames(ctx, data.Sources, func(m *bridgeSourceModel) string { return m.name(ctx) })
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(fwflex.Flatten(ctx, apiObject, data, fwflex.WithFieldNamePrefix("Bridge"))...)
+	if diags.HasError() {
+		return diags
+	}
+
+	outputs := sortByNames(apiObject.Outputs, func(v awstypes.BridgeOutput) string {
+		if v := v.NetworkOutput; v != nil {
+			return aws.ToString(v.Name)
+		}
+		return ""
+	}, outputNames)
+	diags.Append(fwflex.Flatten(ctx, outputs, &data.Outputs)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	sources := sortByNames(apiObject.Sources, func(v awstypes.BridgeSource) string {
+		if v := v.FlowSource; v != nil {
+			return aws.ToString(v.Name)
+		}
+		if v := v.NetworkSource; v != nil {
+			return aws.ToString(v.Name)
+		}
+		return ""
+	}, sourceNames)
+	diags.Append(fwflex.Flatten(ctx, sources, &data.Sources)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if v := apiObject.SourceFailoverConfig; sourceFailoverConfigNull && (v == nil || v.State == awstypes.StateDisabled) {
+		data.SourceFailoverConfig = fwtypes.NewListNestedObjectValueOfNull[failoverConfigModel](ctx)
+	}
+
+	return diags
+}
+
+func findBridgeByARN(ctx context.Context, conn *mediaconnect.Client, arn string) (*awstypes.Bridge, error) {
+	input := mediaconnect.DescribeBridgeInput{
+		BridgeArn: aws.String(arn),
+	}
+	output, err := findBridge(ctx, conn, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.BridgeState == awstypes.BridgeStateDeleted {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			Message: string(output.BridgeState),
+		})
+	}
+
+	return output, nil
+}
+
+func findBridge(ctx context.Context, conn *mediaconnect.Client, input *mediaconnect.DescribeBridgeInput) (*awstypes.Bridge, error) {
+	output, err := conn.DescribeBridge(ctx, input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil || output.Bridge == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError())
+	}
+
+	return output.Bridge, nil
+}
+
+func statusBridge(conn *mediaconnect.Client, arn string) retry.StateRefreshFunc {
+	return func(ctx context.Context) (any, string, error) {
+		output, err := findBridgeByARN(ctx, conn, arn)
+
+		if retry.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.BridgeState), nil
+	}
+}
+
+func waitBridgeCreated(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Bridge, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.BridgeStateCreating, awstypes.BridgeStateStartPending, awstypes.BridgeStateStarting, awstypes.BridgeStateDeploying),
+		Target:  enum.Slice(awstypes.BridgeStateStandby, awstypes.BridgeStateActive),
+		Refresh: statusBridge(conn, arn),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Bridge); ok {
+		return output, smarterr.NewError(err)
+	}
+
+	return nil, smarterr.NewError(err)
+}
+
+func waitBridgeUpdated(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Bridge, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(awstypes.BridgeStateUpdating, awstypes.BridgeStateStartPending, awstypes.BridgeStateStarting, awstypes.BridgeStateDeploying),
+		Target:                    enum.Slice(awstypes.BridgeStateStandby, awstypes.BridgeStateActive),
+		Refresh:                   statusBridge(conn, arn),
+		Timeout:                   timeout,
+		Delay:                     5 * time.Second,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Bridge); ok {
+		return output, smarterr.NewError(err)
+	}
+
+	return nil, smarterr.NewError(err)
+}
+
+func waitBridgeDeleted(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Bridge, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.BridgeStateDeleting, awstypes.BridgeStateStopping, awstypes.BridgeStateStandby, awstypes.BridgeStateActive),
+		Target:  []string{},
+		Refresh: statusBridge(conn, arn),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Bridge); ok {
+		return output, smarterr.NewError(err)
+	}
+
+	return nil, smarterr.NewError(err)
+}
+
+type bridgeResourceModel struct {
+	framework.WithRegionModel
+	ARN                  types.String                                               `tfsdk:"arn"`
+	EgressGatewayBridge  fwtypes.ListNestedObjectValueOf[egressGatewayBridgeModel]  `tfsdk:"egress_gateway_bridge"`
+	IngressGatewayBridge fwtypes.ListNestedObjectValueOf[ingressGatewayBridgeModel] `tfsdk:"ingress_gateway_bridge"`
+	Name                 types.String                                               `tfsdk:"name"`
+	Outputs              fwtypes.ListNestedObjectValueOf[bridgeOutputModel]         `tfsdk:"output"`
+	PlacementARN         fwtypes.ARN                                                `tfsdk:"placement_arn"`
+	SourceFailoverConfig fwtypes.ListNestedObjectValueOf[failoverConfigModel]       `tfsdk:"source_failover_config"`
+	Sources              fwtypes.ListNestedObjectValueOf[bridgeSourceModel]         `tfsdk:"source"`
+	Timeouts             timeouts.Value                                             `tfsdk:"timeouts"`
+}
+
+type egressGatewayBridgeModel struct {
+	MaxBitrate types.Int32 `tfsdk:"max_bitrate"`
+}
+
+type ingressGatewayBridgeModel struct {
+	MaxBitrate types.Int32 `tfsdk:"max_bitrate"`
+	MaxOutputs types.Int32 `tfsdk:"max_outputs"`
+}
+
+type bridgeOutputModel struct {
+	NetworkOutput fwtypes.ListNestedObjectValueOf[bridgeNetworkOutputModel] `tfsdk:"network_output"`
+}
+
+func (m *bridgeOutputModel) name(ctx context.Context) string {
+	if v, _ := m.NetworkOutput.ToPtr(ctx); v != nil {
+		return v.Name.ValueString()
+	}
+
+	return ""
+}
+
+type bridgeNetworkOutputModel struct {
+	IPAddress   types.String                          `tfsdk:"ip_address"`
+	Name        types.String                          `tfsdk:"name"`
+	NetworkName types.String                          `tfsdk:"network_name"`
+	Port        types.Int32                           `tfsdk:"port"`
+	Protocol    fwtypes.StringEnum[awstypes.Protocol] `tfsdk:"protocol"`
+	TTL         types.Int32                           `tfsdk:"ttl"`
+}
+
+type bridgeSourceModel struct {
+	FlowSource    fwtypes.ListNestedObjectValueOf[bridgeFlowSourceModel]    `tfsdk:"flow_source"`
+	NetworkSource fwtypes.ListNestedObjectValueOf[bridgeNetworkSourceModel] `tfsdk:"network_source"`
+}
+
+func (m *bridgeSourceModel) name(ctx context.Context) string {
+	if v, _ := m.FlowSource.ToPtr(ctx); v != nil {
+		return v.Name.ValueString()
+	}
+	if v, _ := m.NetworkSource.ToPtr(ctx); v != nil {
+		return v.Name.ValueString()
+	}
+
+	return ""
+}
+
+type bridgeFlowSourceModel struct {
+	FlowARN                    fwtypes.ARN                                                  `tfsdk:"flow_arn"`
+	FlowVPCInterfaceAttachment fwtypes.ListNestedObjectValueOf[vpcInterfaceAttachmentModel] `tfsdk:"flow_vpc_interface_attachment"`
+	Name                       types.String                                                 `tfsdk:"name"`
+	OutputARN                  types.String                                                 `tfsdk:"output_arn"`
+}
+
+type bridgeNetworkSourceModel struct {
+	MulticastIP             types.String                                                  `tfsdk:"multicast_ip"`
+	MulticastSourceSettings fwtypes.ListNestedObjectValueOf[multicastSourceSettingsModel] `tfsdk:"multicast_source_settings"`
+	Name                    types.String                                                  `tfsdk:"name"`
+	NetworkName             types.String                                                  `tfsdk:"network_name"`
+	Port                    types.Int32                                                   `tfsdk:"port"`
+	Protocol                fwtypes.StringEnum[awstypes.Protocol]                         `tfsdk:"protocol"`
+}
+
+type multicastSourceSettingsModel struct {
+	MulticastSourceIP types.String `tfsdk:"multicast_source_ip"`
+}
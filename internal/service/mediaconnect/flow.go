@@ -0,0 +1,1419 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediaconnect"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediaconnect/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_mediaconnect_flow", name="Flow")
+// @Tags(identifierAttribute="arn")
+// @ArnIdentity
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/mediaconnect/types;awstypes;awstypes.Flow")
+// @Testing(importStateIdAttribute="arn")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(hasNoPreExistingResource=true)
+func newFlowResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &flowResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+var maintenanceStartHourRegexp = regexache.MustCompile(`^([01]\d|2[0-3]):00$`)
+
+type flowResource struct {
+	framework.ResourceWithModel[flowResourceModel]
+	framework.WithTimeouts
+	framework.WithImportByIdentity
+}
+
+func (r *flowResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	encryptionBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[encryptionModel](ctx),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"algorithm": schema.StringAttribute{
+						CustomType: fwtypes.StringEnumType[awstypes.Algorithm](),
+						Optional:   true,
+					},
+					"constant_initialization_vector": schema.StringAttribute{
+						Optional: true,
+					},
+					"device_id": schema.StringAttribute{
+						Optional: true,
+					},
+					"key_type": schema.StringAttribute{
+						CustomType: fwtypes.StringEnumType[awstypes.KeyType](),
+						Optional:   true,
+						Computed:   true,
+					},
+					names.AttrRegion: schema.StringAttribute{
+						Optional: true,
+					},
+					names.AttrResourceID: schema.StringAttribute{
+						Optional: true,
+					},
+					names.AttrRoleARN: schema.StringAttribute{
+						CustomType: fwtypes.ARNType,
+						Required:   true,
+					},
+					"secret_arn": schema.StringAttribute{
+						CustomType: fwtypes.ARNType,
+						Optional:   true,
+					},
+					names.AttrURL: schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrAvailabilityZone: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"egress_ip": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.Status](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"entitlement": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[flowEntitlementModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"data_transfer_subscriber_fee_percent": schema.Int32Attribute{
+							Optional: true,
+							Computed: true,
+							Validators: []validator.Int32{
+								int32validator.Between(0, 100),
+							},
+						},
+						names.AttrDescription: schema.StringAttribute{
+							Optional: true,
+						},
+						"entitlement_arn": schema.StringAttribute{
+							Computed: true,
+						},
+						"entitlement_status": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.EntitlementStatus](),
+							Optional:   true,
+							Computed:   true,
+						},
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						"subscribers": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Required:    true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"encryption": encryptionBlock(),
+					},
+				},
+			},
+			"maintenance": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[maintenanceModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"maintenance_day": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.MaintenanceDay](),
+							Required:   true,
+						},
+						"maintenance_start_hour": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(maintenanceStartHourRegexp, "must be in the format HH:00"),
+							},
+						},
+					},
+				},
+			},
+			"output": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[flowOutputModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"cidr_allow_list": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						names.AttrDescription: schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrDestination: schema.StringAttribute{
+							Optional: true,
+						},
+						"max_latency": schema.Int32Attribute{
+							Optional: true,
+							Computed: true,
+						},
+						"min_latency": schema.Int32Attribute{
+							Optional: true,
+							Computed: true,
+						},
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						"output_arn": schema.StringAttribute{
+							Computed: true,
+						},
+						names.AttrPort: schema.Int32Attribute{
+							Optional: true,
+							Computed: true,
+						},
+						names.AttrProtocol: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.Protocol](),
+							Required:   true,
+						},
+						"remote_id": schema.StringAttribute{
+							Optional: true,
+						},
+						"sender_control_port": schema.Int32Attribute{
+							Optional: true,
+						},
+						"smoothing_latency": schema.Int32Attribute{
+							Optional: true,
+							Computed: true,
+						},
+						"stream_id": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"encryption": encryptionBlock(),
+						"vpc_interface_attachment": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[vpcInterfaceAttachmentModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"vpc_interface_name": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrSource: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[flowSourceModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeBetween(1, 2),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrDescription: schema.StringAttribute{
+							Optional: true,
+						},
+						"entitlement_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Optional:   true,
+						},
+						"ingest_ip": schema.StringAttribute{
+							Computed: true,
+						},
+						"ingest_port": schema.Int32Attribute{
+							Optional: true,
+							Computed: true,
+						},
+						"max_bitrate": schema.Int32Attribute{
+							Optional: true,
+							Computed: true,
+						},
+						"max_latency": schema.Int32Attribute{
+							Optional: true,
+							Computed: true,
+						},
+						"min_latency": schema.Int32Attribute{
+							Optional: true,
+							Computed: true,
+						},
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						names.AttrProtocol: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.Protocol](),
+							Optional:   true,
+						},
+						"sender_control_port": schema.Int32Attribute{
+							Optional: true,
+						},
+						"sender_ip_address": schema.StringAttribute{
+							Optional: true,
+						},
+						"source_arn": schema.StringAttribute{
+							Computed: true,
+						},
+						"source_listener_address": schema.StringAttribute{
+							Optional: true,
+						},
+						"source_listener_port": schema.Int32Attribute{
+							Optional: true,
+						},
+						"stream_id": schema.StringAttribute{
+							Optional: true,
+						},
+						"vpc_interface_name": schema.StringAttribute{
+							Optional: true,
+						},
+						"whitelist_cidr": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"decryption": encryptionBlock(),
+					},
+				},
+			},
+			"source_failover_config": sourceFailoverConfigBlock(ctx),
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+			"vpc_interface": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[vpcInterfaceModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						"network_interface_ids": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"network_interface_type": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.NetworkInterfaceType](),
+							Optional:   true,
+							Computed:   true,
+						},
+						names.AttrRoleARN: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+						names.AttrSecurityGroupIDs: schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Required:    true,
+						},
+						names.AttrSubnetID: schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func sourceFailoverConfigBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[failoverConfigModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"failover_mode": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.FailoverMode](),
+					Optional:   true,
+					Computed:   true,
+				},
+				"recovery_window": schema.Int32Attribute{
+					Optional: true,
+					Computed: true,
+				},
+				names.AttrState: schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.State](),
+					Optional:   true,
+					Computed:   true,
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"source_priority": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[sourcePriorityModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"primary_source": schema.StringAttribute{
+								Required: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *flowResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan flowResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, plan.Name)
+	var input mediaconnect.CreateFlowInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, plan, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.FlowTags = getTagsIn(ctx)
+
+	output, err := conn.CreateFlow(ctx, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	arn := aws.ToString(output.Flow.FlowArn)
+	flow, err := waitFlowCreated(ctx, conn, arn, r.CreateTimeout(ctx, plan.Timeouts))
+
+	if err != nil {
+		smerr.AddEnrich(ctx, &response.Diagnostics, response.State.SetAttribute(ctx, path.Root(names.AttrARN), arn))
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, flattenFlow(ctx, flow, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, plan))
+}
+
+func (r *flowResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data flowResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	arn := fwflex.StringValueFromFramework(ctx, data.ARN)
+	output, err := findFlowByARN(ctx, conn, arn)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, flattenFlow(ctx, output, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *flowResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old flowResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &new))
+	if response.Diagnostics.HasError() {
+		return
+	}
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &old))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	arn := fwflex.StringValueFromFramework(ctx, new.ARN)
+	timeout := r.UpdateTimeout(ctx, new.Timeouts)
+
+	// Nested collections are reconciled by name.
+	// The order of operations ensures that VPC interfaces exist before the sources and outputs that use them,
+	// and that sources remain until the failover configuration no longer refers to them.
+	newVPCInterfaces, d := new.VPCInterfaces.ToSlice(ctx)
+	smerr.AddEnrich(ctx, &response.Diagnostics, d)
+	oldVPCInterfaces, d := old.VPCInterfaces.ToSlice(ctx)
+	smerr.AddEnrich(ctx, &response.Diagnostics, d)
+	newSources, d := new.Sources.ToSlice(ctx)
+	smerr.AddEnrich(ctx, &response.Diagnostics, d)
+	oldSources, d := old.Sources.ToSlice(ctx)
+	smerr.AddEnrich(ctx, &response.Diagnostics, d)
+	newOutputs, d := new.Outputs.ToSlice(ctx)
+	smerr.AddEnrich(ctx, &response.Diagnostics, d)
+	oldOutputs, d := old.Outputs.ToSlice(ctx)
+	smerr.AddEnrich(ctx, &response.Diagnostics, d)
+	newEntitlements, d := new.Entitlements.ToSlice(ctx)
+	smerr.AddEnrich(ctx, &response.Diagnostics, d)
+	oldEntitlements, d := old.Entitlements.ToSlice(ctx)
+	smerr.AddEnrich(ctx, &response.Diagnostics, d)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// VPC interfaces can't be modified, so changed interfaces are removed and added again.
+	var addVPCInterfaces []awstypes.VpcInterfaceRequest
+	var removeVPCInterfaces []string
+	for _, v := range newVPCInterfaces {
+		name := v.Name.ValueString()
+		o := findModelByName(oldVPCInterfaces, name, (*vpcInterfaceModel).name)
+
+		if o != nil {
+			diff, d := fwflex.Diff(ctx, v, o)
+			smerr.AddEnrich(ctx, &response.Diagnostics, d)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			if !diff.HasChanges() {
+				continue
+			}
+
+			if err := removeFlowVPCInterface(ctx, conn, arn, name, timeout); err != nil {
+				smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+				return
+			}
+		}
+
+		var apiObject awstypes.VpcInterfaceRequest
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, v, &apiObject))
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		addVPCInterfaces = append(addVPCInterfaces, apiObject)
+	}
+	for _, v := range oldVPCInterfaces {
+		if name := v.Name.ValueString(); findModelByName(newVPCInterfaces, name, (*vpcInterfaceModel).name) == nil {
+			removeVPCInterfaces = append(removeVPCInterfaces, name)
+		}
+	}
+
+	if len(addVPCInterfaces) > 0 {
+		input := mediaconnect.AddFlowVpcInterfacesInput{
+			FlowArn:       aws.String(arn),
+			VpcInterfaces: addVPCInterfaces,
+		}
+		_, err := conn.AddFlowVpcInterfaces(ctx, &input)
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+
+		if _, err := waitFlowUpdated(ctx, conn, arn, timeout); err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+	}
+
+	var addSources []awstypes.SetSourceRequest
+	var removeSources []string
+	for _, v := range newSources {
+		o := findModelByName(oldSources, v.Name.ValueString(), (*flowSourceModel).name)
+
+		if o == nil {
+			var apiObject awstypes.SetSourceRequest
+			smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, v, &apiObject))
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			addSources = append(addSources, apiObject)
+			continue
+		}
+
+		diff, d := fwflex.Diff(ctx, v, o)
+		smerr.AddEnrich(ctx, &response.Diagnostics, d)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if !diff.HasChanges() {
+			continue
+		}
+
+		var input mediaconnect.UpdateFlowSourceInput
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, v, &input))
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.FlowArn = aws.String(arn)
+		input.SourceArn = fwflex.StringFromFramework(ctx, o.SourceARN)
+
+		_, err := conn.UpdateFlowSource(ctx, &input)
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+
+		if _, err := waitFlowUpdated(ctx, conn, arn, timeout); err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+	}
+	for _, v := range oldSources {
+		if findModelByName(newSources, v.Name.ValueString(), (*flowSourceModel).name) == nil {
+			removeSources = append(removeSources, v.SourceARN.ValueString())
+		}
+	}
+
+	if len(addSources) > 0 {
+		input := mediaconnect.AddFlowSourcesInput{
+			FlowArn: aws.String(arn),
+			Sources: addSources,
+		}
+		_, err := conn.AddFlowSources(ctx, &input)
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+
+		if _, err := waitFlowUpdated(ctx, conn, arn, timeout); err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+	}
+
+	if !new.Maintenance.Equal(old.Maintenance) || !new.SourceFailoverConfig.Equal(old.SourceFailoverConfig) {
+		input := mediaconnect.UpdateFlowInput{
+			FlowArn: aws.String(arn),
+		}
+		if !new.Maintenance.Equal(old.Maintenance) {
+			smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, new.Maintenance, &input.Maintenance))
+		}
+		if !new.SourceFailoverConfig.Equal(old.SourceFailoverConfig) {
+			if new.SourceFailoverConfig.IsNull() {
+				input.SourceFailoverConfig = &awstypes.UpdateFailoverConfig{
+					State: awstypes.StateDisabled,
+				}
+			} else {
+				smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, new.SourceFailoverConfig, &input.SourceFailoverConfig))
+			}
+		}
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateFlow(ctx, &input)
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+
+		if _, err := waitFlowUpdated(ctx, conn, arn, timeout); err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+	}
+
+	for _, v := range oldOutputs {
+		if findModelByName(newOutputs, v.Name.ValueString(), (*flowOutputModel).name) != nil {
+			continue
+		}
+
+		input := mediaconnect.RemoveFlowOutputInput{
+			FlowArn:   aws.String(arn),
+			OutputArn: fwflex.StringFromFramework(ctx, v.OutputARN),
+		}
+		_, err := conn.RemoveFlowOutput(ctx, &input)
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+
+		if _, err := waitFlowUpdated(ctx, conn, arn, timeout); err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+	}
+
+	var addOutputs []awstypes.AddOutputRequest
+	for _, v := range newOutputs {
+		o := findModelByName(oldOutputs, v.Name.ValueString(), (*flowOutputModel).name)
+
+		if o == nil {
+			var apiObject awstypes.AddOutputRequest
+			smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, v, &apiObject))
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			addOutputs = append(addOutputs, apiObject)
+			continue
+		}
+
+		diff, d := fwflex.Diff(ctx, v, o)
+		smerr.AddEnrich(ctx, &response.Diagnostics, d)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if !diff.HasChanges() {
+			continue
+		}
+
+		var input mediaconnect.UpdateFlowOutputInput
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, v, &input))
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.FlowArn = aws.String(arn)
+		input.OutputArn = fwflex.StringFromFramework(ctx, o.OutputARN)
+
+		_, err := conn.UpdateFlowOutput(ctx, &input)
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+
+		if _, err := waitFlowUpdated(ctx, conn, arn, timeout); err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+	}
+
+	if len(addOutputs) > 0 {
+		input := mediaconnect.AddFlowOutputsInput{
+			FlowArn: aws.String(arn),
+			Outputs: addOutputs,
+		}
+		_, err := conn.AddFlowOutputs(ctx, &input)
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+
+		if _, err := waitFlowUpdated(ctx, conn, arn, timeout); err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+	}
+
+	// The data transfer subscriber fee can't be modified, so entitlements whose fee changes are revoked and granted again.
+	var grantEntitlements []awstypes.GrantEntitlementRequest
+	for _, v := range oldEntitlements {
+		n := findModelByName(newEntitlements, v.Name.ValueString(), (*flowEntitlementModel).name)
+
+		if n != nil && (n.DataTransferSubscriberFeePercent.IsUnknown() || n.DataTransferSubscriberFeePercent.Equal(v.DataTransferSubscriberFeePercent)) {
+			continue
+		}
+
+		if err := revokeFlowEntitlement(ctx, conn, arn, v.EntitlementARN.ValueString(), timeout); err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+	}
+	for _, v := range newEntitlements {
+		o := findModelByName(oldEntitlements, v.Name.ValueString(), (*flowEntitlementModel).name)
+
+		if o == nil || (!v.DataTransferSubscriberFeePercent.IsUnknown() && !v.DataTransferSubscriberFeePercent.Equal(o.DataTransferSubscriberFeePercent)) {
+			var apiObject awstypes.GrantEntitlementRequest
+			smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, v, &apiObject))
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			grantEntitlements = append(grantEntitlements, apiObject)
+			continue
+		}
+
+		diff, d := fwflex.Diff(ctx, v, o)
+		smerr.AddEnrich(ctx, &response.Diagnostics, d)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if !diff.HasChanges() {
+			continue
+		}
+
+		var input mediaconnect.UpdateFlowEntitlementInput
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, v, &input))
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.EntitlementArn = fwflex.StringFromFramework(ctx, o.EntitlementARN)
+		input.FlowArn = aws.String(arn)
+
+		_, err := conn.UpdateFlowEntitlement(ctx, &input)
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+	}
+
+	if len(grantEntitlements) > 0 {
+		input := mediaconnect.GrantFlowEntitlementsInput{
+			Entitlements: grantEntitlements,
+			FlowArn:      aws.String(arn),
+		}
+		_, err := conn.GrantFlowEntitlements(ctx, &input)
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+	}
+
+	for _, sourceARN := range removeSources {
+		input := mediaconnect.RemoveFlowSourceInput{
+			FlowArn:   aws.String(arn),
+			SourceArn: aws.String(sourceARN),
+		}
+		_, err := conn.RemoveFlowSource(ctx, &input)
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+
+		if _, err := waitFlowUpdated(ctx, conn, arn, timeout); err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+	}
+
+	for _, name := range removeVPCInterfaces {
+		if err := removeFlowVPCInterface(ctx, conn, arn, name, timeout); err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+	}
+
+	flow, err := waitFlowUpdated(ctx, conn, arn, timeout)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, flattenFlow(ctx, flow, &new))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &new))
+}
+
+func (r *flowResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data flowResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	arn := fwflex.StringValueFromFramework(ctx, data.ARN)
+	if err := deleteFlow(ctx, conn, arn, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+		return
+	}
+}
+
+// deleteFlow stops the flow if it is running and then deletes it.
+func deleteFlow(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) error {
+	flow, err := findFlowByARN(ctx, conn, arn)
+
+	if retry.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if flow.Status == awstypes.StatusActive || flow.Status == awstypes.StatusStarting {
+		input := mediaconnect.StopFlowInput{
+			FlowArn: aws.String(arn),
+		}
+		_, err := conn.StopFlow(ctx, &input)
+
+		if err != nil {
+			return smarterr.NewError(err)
+		}
+
+		if _, err := waitFlowStopped(ctx, conn, arn, timeout); err != nil {
+			return err
+		}
+	}
+
+	input := mediaconnect.DeleteFlowInput{
+		FlowArn: aws.String(arn),
+	}
+	_, err = conn.DeleteFlow(ctx, &input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return smarterr.NewError(err)
+	}
+
+	if _, err := waitFlowDeleted(ctx, conn, arn, timeout); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func removeFlowVPCInterface(ctx context.Context, conn *mediaconnect.Client, arn, name string, timeout time.Duration) error {
+	input := mediaconnect.RemoveFlowVpcInterfaceInput{
+		FlowArn:          aws.String(arn),
+		VpcInterfaceName: aws.String(name),
+	}
+	_, err := conn.RemoveFlowVpcInterface(ctx, &input)
+
+	if err != nil {
+		return smarterr.NewError(err)
+	}
+
+	if _, err := waitFlowUpdated(ctx, conn, arn, timeout); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func revokeFlowEntitlement(ctx context.Context, conn *mediaconnect.Client, arn, entitlementARN string, timeout time.Duration) error {
+	input := mediaconnect.RevokeFlowEntitlementInput{
+		EntitlementArn: aws.String(entitlementARN),
+		FlowArn:        aws.String(arn),
+	}
+	_, err := conn.RevokeFlowEntitlement(ctx, &input)
+
+	if err != nil {
+		return smarterr.NewError(err)
+	}
+
+	if _, err := waitFlowUpdated(ctx, conn, arn, timeout); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// flattenFlow flattens the flow into the resource model.
+// Sources, outputs, entitlements and VPC interfaces are kept in the order in which they were configured.
+// MediaConnect assigns a maintenance window and a disabled failover configuration to every flow,
+// so those are only kept in state if the corresponding block was already present.
+func flattenFlow(ctx context.Context, apiObject *awstypes.Flow, data *flowResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	maintenanceNull, sourceFailoverConfigNull := data.Maintenance.IsNull(), data.SourceFailoverConfig.IsNull()
+	entitlementNames, d := modelNames(ctx, data.Entitlements, (*flowEntitlementModel).name)
+	diags.Append(d...)
+	outputNames, d := modelNames(ctx, data.Outputs, (*flowOutputModel).name)
+	diags.Append(d...)
+	sourceNames, d := modelNames(ctx, data.Sources, (*flowSourceModel).name)
+	diags.Append(d...)
+	vpcInterfaceNames, d := modelNames(ctx, data.VPCInterfaces, (*vpcInterfaceModel).name)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(fwflex.Flatten(ctx, apiObject, data, fwflex.WithFieldNamePrefix("Flow"), fwflex.WithIgnoredFieldNamesAppend("Source"))...)
+	if diags.HasError() {
+		return diags
+	}
+
+	entitlements := sortByNames(apiObject.Entitlements, func(v awstypes.Entitlement) string { return aws.ToString(v.Name) }, entitlementNames)
+	diags.Append(fwflex.Flatten(ctx, entitlements, &data.Entitlements)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	vpcInterfaces := sortByNames(apiObject.VpcInterfaces, func(v awstypes.VpcInterface) string { return aws.ToString(v.Name) }, vpcInterfaceNames)
+	diags.Append(fwflex.Flatten(ctx, vpcInterfaces, &data.VPCInterfaces)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	sources := apiObject.Sources
+	if len(sources) == 0 && apiObject.Source != nil {
+		sources = []awstypes.Source{*apiObject.Source}
+	}
+	sources = sortByNames(sources, func(v awstypes.Source) string { return aws.ToString(v.Name) }, sourceNames)
+	sourceModels := make([]flowSourceModel, 0, len(sources))
+	for _, v := range sources {
+		m, d := flattenFlowSource(ctx, &v)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		sourceModels = append(sourceModels, m)
+	}
+	data.Sources = fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, sourceModels)
+
+	outputs := sortByNames(apiObject.Outputs, func(v awstypes.Output) string { return aws.ToString(v.Name) }, outputNames)
+	outputModels := make([]flowOutputModel, 0, len(outputs))
+	for _, v := range outputs {
+		m, d := flattenFlowOutput(ctx, &v)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		outputModels = append(outputModels, m)
+	}
+	data.Outputs = fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, outputModels)
+
+	if maintenanceNull {
+		data.Maintenance = fwtypes.NewListNestedObjectValueOfNull[maintenanceModel](ctx)
+	}
+
+	if v := apiObject.SourceFailoverConfig; sourceFailoverConfigNull && (v == nil || v.State == awstypes.StateDisabled) {
+		data.SourceFailoverConfig = fwtypes.NewListNestedObjectValueOfNull[failoverConfigModel](ctx)
+	}
+
+	return diags
+}
+
+// flattenFlowSource flattens a flow source.
+// Most of the source's transport settings are returned in a separate structure.
+func flattenFlowSource(ctx context.Context, apiObject *awstypes.Source) (flowSourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var data flowSourceModel
+
+	diags.Append(fwflex.Flatten(ctx, apiObject, &data)...)
+	if diags.HasError() {
+		return data, diags
+	}
+
+	transport := apiObject.Transport
+	if transport == nil {
+		transport = &awstypes.Transport{}
+	}
+
+	data.MaxBitrate = fwflex.Int32ToFramework(ctx, transport.MaxBitrate)
+	data.MaxLatency = fwflex.Int32ToFramework(ctx, transport.MaxLatency)
+	data.MinLatency = fwflex.Int32ToFramework(ctx, transport.MinLatency)
+	data.Protocol = protocolToFramework(transport.Protocol)
+	if apiObject.SenderControlPort == nil {
+		data.SenderControlPort = fwflex.Int32ToFramework(ctx, transport.SenderControlPort)
+	}
+	if apiObject.SenderIpAddress == nil {
+		data.SenderIPAddress = fwflex.StringToFramework(ctx, transport.SenderIpAddress)
+	}
+	data.SourceListenerAddress = fwflex.StringToFramework(ctx, transport.SourceListenerAddress)
+	data.SourceListenerPort = fwflex.Int32ToFramework(ctx, transport.SourceListenerPort)
+	data.StreamID = fwflex.StringToFramework(ctx, transport.StreamId)
+
+	return data, diags
+}
+
+// flattenFlowOutput flattens a flow output.
+// Most of the output's transport settings are returned in a separate structure.
+func flattenFlowOutput(ctx context.Context, apiObject *awstypes.Output) (flowOutputModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var data flowOutputModel
+
+	diags.Append(fwflex.Flatten(ctx, apiObject, &data)...)
+	if diags.HasError() {
+		return data, diags
+	}
+
+	transport := apiObject.Transport
+	if transport == nil {
+		transport = &awstypes.Transport{}
+	}
+
+	data.CIDRAllowList = fwflex.FlattenFrameworkStringValueListOfString(ctx, transport.CidrAllowList)
+	data.MaxLatency = fwflex.Int32ToFramework(ctx, transport.MaxLatency)
+	data.MinLatency = fwflex.Int32ToFramework(ctx, transport.MinLatency)
+	data.Protocol = protocolToFramework(transport.Protocol)
+	data.RemoteID = fwflex.StringToFramework(ctx, transport.RemoteId)
+	data.SenderControlPort = fwflex.Int32ToFramework(ctx, transport.SenderControlPort)
+	data.SmoothingLatency = fwflex.Int32ToFramework(ctx, transport.SmoothingLatency)
+	data.StreamID = fwflex.StringToFramework(ctx, transport.StreamId)
+
+	return data, diags
+}
+
+func protocolToFramework(v awstypes.Protocol) fwtypes.StringEnum[awstypes.Protocol] {
+	if v == "" {
+		return fwtypes.StringEnumNull[awstypes.Protocol]()
+	}
+
+	return fwtypes.StringEnumValue(v)
+}
+
+// sortByNames orders API objects to match the given names,
+// with any objects not named retaining their relative order at the end.
+func sortByNames[T any](apiObjects []T, name func(T) string, order []string) []T {
+	apiObjects = slices.Clone(apiObjects)
+
+	index := func(v T) int {
+		if i := slices.Index(order, name(v)); i >= 0 {
+			return i
+		}
+		return len(order)
+	}
+	slices.SortStableFunc(apiObjects, func(a, b T) int {
+		return cmp.Compare(index(a), index(b))
+	})
+
+	return apiObjects
+}
+
+func modelNames[T any](ctx context.Context, v fwtypes.ListNestedObjectValueOf[T], name func(*T) string) ([]string, diag.Diagnostics) {
+	models, diags := v.ToSlice(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return tfslices.ApplyToAll(models, name), diags
+}
+
+func findModelByName[T any](models []*T, v string, name func(*T) string) *T {
+	for _, m := range models {
+		if name(m) == v {
+			return m
+		}
+	}
+
+	return nil
+}
+
+func findFlowByARN(ctx context.Context, conn *mediaconnect.Client, arn string) (*awstypes.Flow, error) {
+	input := mediaconnect.DescribeFlowInput{
+		FlowArn: aws.String(arn),
+	}
+
+	return findFlow(ctx, conn, &input)
+}
+
+func findFlow(ctx context.Context, conn *mediaconnect.Client, input *mediaconnect.DescribeFlowInput) (*awstypes.Flow, error) {
+	output, err := conn.DescribeFlow(ctx, input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil || output.Flow == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError())
+	}
+
+	return output.Flow, nil
+}
+
+func statusFlow(conn *mediaconnect.Client, arn string) retry.StateRefreshFunc {
+	return func(ctx context.Context) (any, string, error) {
+		output, err := findFlowByARN(ctx, conn, arn)
+
+		if retry.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitFlowCreated(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Flow, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StatusUpdating),
+		Target:  enum.Slice(awstypes.StatusStandby),
+		Refresh: statusFlow(conn, arn),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Flow); ok {
+		return output, smarterr.NewError(err)
+	}
+
+	return nil, smarterr.NewError(err)
+}
+
+func waitFlowUpdated(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Flow, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(awstypes.StatusUpdating, awstypes.StatusStarting, awstypes.StatusStopping),
+		Target:                    enum.Slice(awstypes.StatusStandby, awstypes.StatusActive),
+		Refresh:                   statusFlow(conn, arn),
+		Timeout:                   timeout,
+		Delay:                     5 * time.Second,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Flow); ok {
+		return output, smarterr.NewError(err)
+	}
+
+	return nil, smarterr.NewError(err)
+}
+
+func waitFlowStopped(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Flow, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StatusActive, awstypes.StatusStopping, awstypes.StatusUpdating),
+		Target:  enum.Slice(awstypes.StatusStandby),
+		Refresh: statusFlow(conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Flow); ok {
+		return output, smarterr.NewError(err)
+	}
+
+	return nil, smarterr.NewError(err)
+}
+
+func waitFlowDeleted(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Flow, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StatusDeleting, awstypes.StatusStandby),
+		Target:  []string{},
+		Refresh: statusFlow(conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Flow); ok {
+		return output, smarterr.NewError(err)
+	}
+
+	return nil, smarterr.NewError(err)
+}
+
+type flowResourceModel struct {
+	framework.WithRegionModel
+	ARN                  types.String                                          `tfsdk:"arn"`
+	AvailabilityZone     types.String                                          `tfsdk:"availability_zone"`
+	EgressIP             types.String                                          `tfsdk:"egress_ip"`
+	Entitlements         fwtypes.ListNestedObjectValueOf[flowEntitlementModel] `tfsdk:"entitlement"`
+	Maintenance          fwtypes.ListNestedObjectValueOf[maintenanceModel]     `tfsdk:"maintenance"`
+	Name                 types.String                                          `tfsdk:"name"`
+	Outputs              fwtypes.ListNestedObjectValueOf[flowOutputModel]      `tfsdk:"output"`
+	SourceFailoverConfig fwtypes.ListNestedObjectValueOf[failoverConfigModel]  `tfsdk:"source_failover_config"`
+	Sources              fwtypes.ListNestedObjectValueOf[flowSourceModel]      `tfsdk:"source"`
+	Status               fwtypes.StringEnum[awstypes.Status]                   `tfsdk:"status"`
+	Tags                 tftags.Map                                            `tfsdk:"tags"`
+	TagsAll              tftags.Map                                            `tfsdk:"tags_all"`
+	Timeouts             timeouts.Value                                        `tfsdk:"timeouts"`
+	VPCInterfaces        fwtypes.ListNestedObjectValueOf[vpcInterfaceModel]    `tfsdk:"vpc_interface"`
+}
+
+type flowEntitlementModel struct {
+	DataTransferSubscriberFeePercent types.Int32                                      `tfsdk:"data_transfer_subscriber_fee_percent"`
+	Description                      types.String                                     `tfsdk:"description"`
+	Encryption                       fwtypes.ListNestedObjectValueOf[encryptionModel] `tfsdk:"encryption"`
+	EntitlementARN                   types.String                                     `tfsdk:"entitlement_arn"`
+	EntitlementStatus                fwtypes.StringEnum[awstypes.EntitlementStatus]   `tfsdk:"entitlement_status"`
+	Name                             types.String                                     `tfsdk:"name"`
+	Subscribers                      fwtypes.ListOfString                             `tfsdk:"subscribers"`
+}
+
+func (m *flowEntitlementModel) name() string {
+	return m.Name.ValueString()
+}
+
+type flowOutputModel struct {
+	CIDRAllowList          fwtypes.ListOfString                                         `tfsdk:"cidr_allow_list"`
+	Description            types.String                                                 `tfsdk:"description"`
+	Destination            types.String                                                 `tfsdk:"destination"`
+	Encryption             fwtypes.ListNestedObjectValueOf[encryptionModel]             `tfsdk:"encryption"`
+	MaxLatency             types.Int32                                                  `tfsdk:"max_latency"`
+	MinLatency             types.Int32                                                  `tfsdk:"min_latency"`
+	Name                   types.String                                                 `tfsdk:"name"`
+	OutputARN              types.String                                                 `tfsdk:"output_arn"`
+	Port                   types.Int32                                                  `tfsdk:"port"`
+	Protocol               fwtypes.StringEnum[awstypes.Protocol]                        `tfsdk:"protocol"`
+	RemoteID               types.String                                                 `tfsdk:"remote_id"`
+	SenderControlPort      types.Int32                                                  `tfsdk:"sender_control_port"`
+	SmoothingLatency       types.Int32                                                  `tfsdk:"smoothing_latency"`
+	StreamID               types.String                                                 `tfsdk:"stream_id"`
+	VPCInterfaceAttachment fwtypes.ListNestedObjectValueOf[vpcInterfaceAttachmentModel] `tfsdk:"vpc_interface_attachment"`
+}
+
+func (m *flowOutputModel) name() string {
+	return m.Name.ValueString()
+}
+
+type flowSourceModel struct {
+	Decryption            fwtypes.ListNestedObjectValueOf[encryptionModel] `tfsdk:"decryption"`
+	Description           types.String                                     `tfsdk:"description"`
+	EntitlementARN        fwtypes.ARN                                      `tfsdk:"entitlement_arn"`
+	IngestIP              types.String                                     `tfsdk:"ingest_ip"`
+	IngestPort            types.Int32                                      `tfsdk:"ingest_port"`
+	MaxBitrate            types.Int32                                      `tfsdk:"max_bitrate"`
+	MaxLatency            types.Int32                                      `tfsdk:"max_latency"`
+	MinLatency            types.Int32                                      `tfsdk:"min_latency"`
+	Name                  types.String                                     `tfsdk:"name"`
+	Protocol              fwtypes.StringEnum[awstypes.Protocol]            `tfsdk:"protocol"`
+	SenderControlPort     types.Int32                                      `tfsdk:"sender_control_port"`
+	SenderIPAddress       types.String                                     `tfsdk:"sender_ip_address"`
+	SourceARN             types.String                                     `tfsdk:"source_arn"`
+	SourceListenerAddress types.String                                     `tfsdk:"source_listener_address"`
+	SourceListenerPort    types.Int32                                      `tfsdk:"source_listener_port"`
+	StreamID              types.String                                     `tfsdk:"stream_id"`
+	VPCInterfaceName      types.String                                     `tfsdk:"vpc_interface_name"`
+	WhitelistCIDR         types.String                                     `tfsdk:"whitelist_cidr"`
+}
+
+func (m *flowSourceModel) name() string {
+	return m.Name.ValueString()
+}
+
+type encryptionModel struct {
+	Algorithm                    fwtypes.StringEnum[awstypes.Algorithm] `tfsdk:"algorithm"`
+	ConstantInitializationVector types.String                           `tfsdk:"constant_initialization_vector"`
+	DeviceID                     types.String                           `tfsdk:"device_id"`
+	KeyType                      fwtypes.StringEnum[awstypes.KeyType]   `tfsdk:"key_type"`
+	Region                       types.String                           `tfsdk:"region"`
+	ResourceID                   types.String                           `tfsdk:"resource_id"`
+	RoleARN                      fwtypes.ARN                            `tfsdk:"role_arn"`
+	SecretARN                    fwtypes.ARN                            `tfsdk:"secret_arn"`
+	URL                          types.String                           `tfsdk:"url"`
+}
+
+type maintenanceModel struct {
+	MaintenanceDay       fwtypes.StringEnum[awstypes.MaintenanceDay] `tfsdk:"maintenance_day"`
+	MaintenanceStartHour types.String                                `tfsdk:"maintenance_start_hour"`
+}
+
+type failoverConfigModel struct {
+	FailoverMode   fwtypes.StringEnum[awstypes.FailoverMode]            `tfsdk:"failover_mode"`
+	RecoveryWindow types.Int32                                          `tfsdk:"recovery_window"`
+	SourcePriority fwtypes.ListNestedObjectValueOf[sourcePriorityModel] `tfsdk:"source_priority"`
+	State          fwtypes.StringEnum[awstypes.State]                   `tfsdk:"state"`
+}
+
+type sourcePriorityModel struct {
+	PrimarySource types.String `tfsdk:"primary_source"`
+}
+
+type vpcInterfaceModel struct {
+	Name                 types.String                                      `tfsdk:"name"`
+	NetworkInterfaceIDs  fwtypes.ListOfString                              `tfsdk:"network_interface_ids"`
+	NetworkInterfaceType fwtypes.StringEnum[awstypes.NetworkInterfaceType] `tfsdk:"network_interface_type"`
+	RoleARN              fwtypes.ARN                                       `tfsdk:"role_arn"`
+	SecurityGroupIDs     fwtypes.SetOfString                               `tfsdk:"security_group_ids"`
+	SubnetID             types.String                                      `tfsdk:"subnet_id"`
+}
+
+func (m *vpcInterfaceModel) name() string {
+	return m.Name.ValueString()
+}
+
+type vpcInterfaceAttachmentModel struct {
+	VPCInterfaceName types.String `tfsdk:"vpc_interface_name"`
+}
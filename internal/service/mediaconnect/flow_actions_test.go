@@ -0,0 +1,143 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediaconnect/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfmediaconnect "github.com/hashicorp/terraform-provider-aws/internal/service/mediaconnect"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMediaConnectStartFlowAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_mediaconnect_flow.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckFlowDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartFlowActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowStatus(ctx, t, resourceName, awstypes.StatusActive),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaConnectStopFlowAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_mediaconnect_flow.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckFlowDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartFlowActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowStatus(ctx, t, resourceName, awstypes.StatusActive),
+				),
+			},
+			{
+				Config: testAccStopFlowActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowStatus(ctx, t, resourceName, awstypes.StatusStandby),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFlowStatus(ctx context.Context, t *testing.T, n string, want awstypes.Status) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).MediaConnectClient(ctx)
+
+		output, err := tfmediaconnect.FindFlowByARN(ctx, conn, rs.Primary.Attributes[names.AttrARN])
+
+		if err != nil {
+			return err
+		}
+
+		if got := output.Status; got != want {
+			return fmt.Errorf("MediaConnect Flow %s status = %s, want %s", rs.Primary.Attributes[names.AttrARN], got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccStartFlowActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccFlowConfig_basic(rName), `
+action "aws_mediaconnect_start_flow" "test" {
+  config {
+    flow_arn = aws_mediaconnect_flow.test.arn
+  }
+}
+
+resource "terraform_data" "start" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_mediaconnect_start_flow.test]
+    }
+  }
+
+  depends_on = [aws_mediaconnect_flow.test]
+}
+`)
+}
+
+func testAccStopFlowActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccStartFlowActionConfig_basic(rName), `
+action "aws_mediaconnect_stop_flow" "test" {
+  config {
+    flow_arn = aws_mediaconnect_flow.test.arn
+  }
+}
+
+resource "terraform_data" "stop" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_mediaconnect_stop_flow.test]
+    }
+  }
+
+  depends_on = [terraform_data.start]
+}
+`)
+}
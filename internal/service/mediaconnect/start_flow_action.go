@@ -0,0 +1,143 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediaconnect"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediaconnect/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_mediaconnect_start_flow, name="Start Flow")
+func newStartFlowAction(context.Context) (action.ActionWithConfigure, error) {
+	return &startFlowAction{}, nil
+}
+
+type startFlowAction struct {
+	framework.ActionWithModel[startFlowActionModel]
+}
+
+type startFlowActionModel struct {
+	framework.WithRegionModel
+	FlowARN fwtypes.ARN `tfsdk:"flow_arn"`
+	Timeout types.Int64 `tfsdk:"timeout"`
+}
+
+func (a *startFlowAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts an AWS Elemental MediaConnect flow. This action is synchronous and waits for the flow to become active.",
+		Attributes: map[string]schema.Attribute{
+			"flow_arn": schema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Description: "ARN of the MediaConnect flow to start",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the flow to become active (default: 600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(30),
+				},
+			},
+		},
+	}
+}
+
+func (a *startFlowAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var model startFlowActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().MediaConnectClient(ctx)
+
+	arn := fwflex.StringValueFromFramework(ctx, model.FlowARN)
+	timeout := fwactions.TimeoutOr(model.Timeout, 10*time.Minute)
+
+	tflog.Info(ctx, "Starting MediaConnect flow", map[string]any{
+		"flow_arn": arn,
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Starting MediaConnect flow %s...", arn)
+
+	flow, err := findFlowByARN(ctx, conn, arn)
+	if err != nil {
+		resp.Diagnostics.AddError("Describing MediaConnect flow", err.Error())
+		return
+	}
+
+	if flow.Status == awstypes.StatusActive {
+		cb(ctx, "Flow %s is already active", arn)
+		return
+	}
+
+	input := mediaconnect.StartFlowInput{
+		FlowArn: aws.String(arn),
+	}
+	if _, err := conn.StartFlow(ctx, &input); err != nil {
+		resp.Diagnostics.AddError("Starting MediaConnect flow", err.Error())
+		return
+	}
+
+	cb(ctx, "Flow %s starting, waiting for it to become active...", arn)
+
+	_, err = actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.Flow], error) {
+		output, err := findFlowByARN(ctx, conn, arn)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.Flow]{}, err
+		}
+		return actionwait.FetchResult[*awstypes.Flow]{Status: actionwait.Status(output.Status), Value: output}, nil
+	}, actionwait.Options[*awstypes.Flow]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(5 * time.Second),
+		ProgressInterval: 30 * time.Second,
+		SuccessStates:    []actionwait.Status{actionwait.Status(awstypes.StatusActive)},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.StatusStandby),
+			actionwait.Status(awstypes.StatusStarting),
+			actionwait.Status(awstypes.StatusUpdating),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.StatusError),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			cb(ctx, "Flow currently in state: %s", fr.Status)
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError("Flow start timeout", "MediaConnect flow "+arn+" did not become active within the specified timeout")
+		} else if errors.As(err, &failureErr) {
+			resp.Diagnostics.AddError("Flow start failed", "MediaConnect flow "+arn+" entered a failure state: "+err.Error())
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError("Unexpected flow state", err.Error())
+		} else {
+			resp.Diagnostics.AddError("Error waiting for flow to start", err.Error())
+		}
+		return
+	}
+
+	cb(ctx, "Flow %s is active", arn)
+}
@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediaconnect"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	awsv2.Register("aws_mediaconnect_bridge", sweepBridges)
+	awsv2.Register("aws_mediaconnect_flow", sweepFlows, "aws_mediaconnect_bridge")
+	awsv2.Register("aws_mediaconnect_gateway", sweepGateways, "aws_mediaconnect_bridge")
+}
+
+func sweepBridges(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.MediaConnectClient(ctx)
+	var input mediaconnect.ListBridgesInput
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := mediaconnect.NewListBridgesPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Bridges {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newBridgeResource, client,
+				framework.NewAttribute(names.AttrARN, aws.ToString(v.BridgeArn))))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepFlows(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.MediaConnectClient(ctx)
+	var input mediaconnect.ListFlowsInput
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := mediaconnect.NewListFlowsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Flows {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newFlowResource, client,
+				framework.NewAttribute(names.AttrARN, aws.ToString(v.FlowArn))))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepGateways(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.MediaConnectClient(ctx)
+	var input mediaconnect.ListGatewaysInput
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := mediaconnect.NewListGatewaysPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Gateways {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newGatewayResource, client,
+				framework.NewAttribute(names.AttrARN, aws.ToString(v.GatewayArn))))
+		}
+	}
+
+	return sweepResources, nil
+}
@@ -0,0 +1,48 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_mediaconnect_bridge" "test" {
+  region = var.region
+
+  name          = var.rName
+  placement_arn = aws_mediaconnect_gateway.test.arn
+
+  ingress_gateway_bridge {
+    max_bitrate = 10000000
+    max_outputs = 2
+  }
+
+  source {
+    network_source {
+      name         = "source-1"
+      multicast_ip = "224.0.0.10"
+      network_name = "network-1"
+      port         = 5000
+      protocol     = "rtp"
+    }
+  }
+}
+
+resource "aws_mediaconnect_gateway" "test" {
+  region = var.region
+
+  name               = var.rName
+  egress_cidr_blocks = ["10.0.0.0/16"]
+
+  network {
+    cidr_block = "10.1.0.0/16"
+    name       = "network-1"
+  }
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "region" {
+  description = "Region to deploy resource in"
+  type        = string
+  nullable    = false
+}
@@ -0,0 +1,19 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_mediaconnect_flow" "test" {
+  name = var.rName
+
+  source {
+    name           = var.rName
+    protocol       = "rtp"
+    ingest_port    = 5000
+    whitelist_cidr = "10.24.34.0/23"
+  }
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
@@ -0,0 +1,18 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_mediaconnect_gateway" "test" {
+  name               = var.rName
+  egress_cidr_blocks = ["10.0.0.0/16"]
+
+  network {
+    cidr_block = "10.1.0.0/16"
+    name       = "network-1"
+  }
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
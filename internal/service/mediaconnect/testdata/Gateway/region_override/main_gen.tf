@@ -0,0 +1,26 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_mediaconnect_gateway" "test" {
+  region = var.region
+
+  name               = var.rName
+  egress_cidr_blocks = ["10.0.0.0/16"]
+
+  network {
+    cidr_block = "10.1.0.0/16"
+    name       = "network-1"
+  }
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "region" {
+  description = "Region to deploy resource in"
+  type        = string
+  nullable    = false
+}
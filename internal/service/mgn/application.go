@@ -0,0 +1,342 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn
+
+import (
+	"context"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mgn"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mgn/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_mgn_application", name="Application")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/mgn/types;awstypes;awstypes.Application")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(hasNoPreExistingResource=true)
+func newApplicationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &applicationResource{}, nil
+}
+
+type applicationResource struct {
+	framework.ResourceWithModel[applicationResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *applicationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(600),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"wave_id": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (r *applicationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan applicationResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, plan.Name)
+	var input mgn.CreateApplicationInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, plan, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateApplication(ctx, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	id := aws.ToString(output.ApplicationID)
+
+	// Set values for unknowns.
+	plan.ARN = fwflex.StringToFramework(ctx, output.Arn)
+	plan.ID = fwflex.StringValueToFramework(ctx, id)
+
+	if waveID := fwflex.StringValueFromFramework(ctx, plan.WaveID); waveID != "" {
+		if err := associateApplication(ctx, conn, id, waveID); err != nil {
+			smerr.AddEnrich(ctx, &response.Diagnostics, response.State.SetAttribute(ctx, path.Root(names.AttrID), id))
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+			return
+		}
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, plan))
+}
+
+func (r *applicationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data applicationResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.ID)
+	output, err := findApplicationByID(ctx, conn, id)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *applicationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old applicationResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &new))
+	if response.Diagnostics.HasError() {
+		return
+	}
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &old))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, new.ID)
+	diff, d := fwflex.Diff(ctx, new, old, fwflex.WithIgnoredField("WaveID"))
+	smerr.AddEnrich(ctx, &response.Diagnostics, d)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		var input mgn.UpdateApplicationInput
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, new, &input))
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.ApplicationID = aws.String(id)
+		if new.Description.IsNull() {
+			input.Description = aws.String("")
+		}
+
+		_, err := conn.UpdateApplication(ctx, &input)
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+			return
+		}
+	}
+
+	if !new.WaveID.Equal(old.WaveID) {
+		if waveID := fwflex.StringValueFromFramework(ctx, old.WaveID); waveID != "" {
+			if err := disassociateApplication(ctx, conn, id, waveID); err != nil {
+				smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+				return
+			}
+		}
+
+		if waveID := fwflex.StringValueFromFramework(ctx, new.WaveID); waveID != "" {
+			if err := associateApplication(ctx, conn, id, waveID); err != nil {
+				smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+				return
+			}
+		}
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &new))
+}
+
+func (r *applicationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data applicationResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.ID)
+
+	if waveID := fwflex.StringValueFromFramework(ctx, data.WaveID); waveID != "" {
+		err := disassociateApplication(ctx, conn, id, waveID)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return
+		}
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+			return
+		}
+	}
+
+	// Only archived applications can be deleted.
+	archiveInput := mgn.ArchiveApplicationInput{
+		ApplicationID: aws.String(id),
+	}
+	_, err := conn.ArchiveApplication(ctx, &archiveInput)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	input := mgn.DeleteApplicationInput{
+		ApplicationID: aws.String(id),
+	}
+	_, err = conn.DeleteApplication(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+}
+
+func associateApplication(ctx context.Context, conn *mgn.Client, applicationID, waveID string) error {
+	input := mgn.AssociateApplicationsInput{
+		ApplicationIDs: []string{applicationID},
+		WaveID:         aws.String(waveID),
+	}
+	_, err := conn.AssociateApplications(ctx, &input)
+
+	if err != nil {
+		return smarterr.NewError(err)
+	}
+
+	return nil
+}
+
+func disassociateApplication(ctx context.Context, conn *mgn.Client, applicationID, waveID string) error {
+	input := mgn.DisassociateApplicationsInput{
+		ApplicationIDs: []string{applicationID},
+		WaveID:         aws.String(waveID),
+	}
+	_, err := conn.DisassociateApplications(ctx, &input)
+
+	if err != nil {
+		return smarterr.NewError(err)
+	}
+
+	return nil
+}
+
+func findApplicationByID(ctx context.Context, conn *mgn.Client, id string) (*awstypes.Application, error) {
+	input := mgn.ListApplicationsInput{
+		Filters: &awstypes.ListApplicationsRequestFilters{
+			ApplicationIDs: []string{id},
+		},
+	}
+
+	return findApplication(ctx, conn, &input)
+}
+
+func findApplication(ctx context.Context, conn *mgn.Client, input *mgn.ListApplicationsInput) (*awstypes.Application, error) {
+	output, err := findApplications(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return smarterr.Assert(tfresource.AssertSingleValueResult(output))
+}
+
+func findApplications(ctx context.Context, conn *mgn.Client, input *mgn.ListApplicationsInput) ([]awstypes.Application, error) {
+	var output []awstypes.Application
+
+	pages := mgn.NewListApplicationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, smarterr.NewError(&retry.NotFoundError{
+				LastError: err,
+			})
+		}
+
+		if err != nil {
+			return nil, smarterr.NewError(err)
+		}
+
+		output = append(output, tfslices.Filter(page.Items, func(v awstypes.Application) bool {
+			return !aws.ToBool(v.IsArchived)
+		})...)
+	}
+
+	return output, nil
+}
+
+type applicationResourceModel struct {
+	framework.WithRegionModel
+	ARN         types.String `tfsdk:"arn"`
+	Description types.String `tfsdk:"description" autoflex:",omitempty"`
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Tags        tftags.Map   `tfsdk:"tags"`
+	TagsAll     tftags.Map   `tfsdk:"tags_all"`
+	WaveID      types.String `tfsdk:"wave_id"`
+}
@@ -0,0 +1,17 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn
+
+// Exports for use in tests only.
+var (
+	ResourceApplication                      = newApplicationResource
+	ResourceLaunchConfigurationTemplate      = newLaunchConfigurationTemplateResource
+	ResourceReplicationConfigurationTemplate = newReplicationConfigurationTemplateResource
+	ResourceWave                             = newWaveResource
+
+	FindApplicationByID                      = findApplicationByID
+	FindLaunchConfigurationTemplateByID      = findLaunchConfigurationTemplateByID
+	FindReplicationConfigurationTemplateByID = findReplicationConfigurationTemplateByID
+	FindWaveByID                             = findWaveByID
+)
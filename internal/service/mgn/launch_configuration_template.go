@@ -0,0 +1,496 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn
+
+import (
+	"context"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mgn"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mgn/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_mgn_launch_configuration_template", name="Launch Configuration Template")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/mgn/types;awstypes;awstypes.LaunchConfigurationTemplate")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(hasNoPreExistingResource=true)
+func newLaunchConfigurationTemplateResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &launchConfigurationTemplateResource{}, nil
+}
+
+type launchConfigurationTemplateResource struct {
+	framework.ResourceWithModel[launchConfigurationTemplateResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *launchConfigurationTemplateResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"associate_public_ip_address": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"boot_mode": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.BootMode](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"copy_private_ip": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"copy_tags": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ec2_launch_template_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enable_map_auto_tagging": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enable_parameters_encryption": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"launch_disposition": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.LaunchDisposition](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"map_auto_tagging_mpe_id": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+				},
+			},
+			"parameters_encryption_key": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			"small_volume_max_size": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"target_instance_type_right_sizing_method": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.TargetInstanceTypeRightSizingMethod](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"large_volume_conf": launchTemplateDiskConfBlock(ctx),
+			"licensing": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[licensingModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"os_byol": schema.BoolAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			"post_launch_actions": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[postLaunchActionsModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"cloud_watch_log_group_name": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 512),
+							},
+						},
+						"deployment": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.PostLaunchActionsDeploymentType](),
+							Optional:   true,
+						},
+						"s3_log_bucket": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(3, 63),
+							},
+						},
+						"s3_output_key_prefix": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtMost(256),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"ssm_document": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[ssmDocumentModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(10),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"action_name": schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 256),
+										},
+									},
+									"must_succeed_for_cutover": schema.BoolAttribute{
+										Optional: true,
+									},
+									"ssm_document_name": schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 172),
+										},
+									},
+									"timeout_seconds": schema.Int32Attribute{
+										Optional: true,
+										Validators: []validator.Int32{
+											int32validator.AtLeast(1),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"small_volume_conf": launchTemplateDiskConfBlock(ctx),
+		},
+	}
+}
+
+func launchTemplateDiskConfBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[launchTemplateDiskConfModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrIOPS: schema.Int64Attribute{
+					Optional: true,
+				},
+				names.AttrThroughput: schema.Int64Attribute{
+					Optional: true,
+				},
+				names.AttrVolumeType: schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.VolumeType](),
+					Optional:   true,
+				},
+			},
+		},
+	}
+}
+
+func (r *launchConfigurationTemplateResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan launchConfigurationTemplateResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	var input mgn.CreateLaunchConfigurationTemplateInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, plan, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateLaunchConfigurationTemplate(ctx, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err)
+		return
+	}
+
+	// Set values for unknowns.
+	prior := plan
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = fwflex.StringToFramework(ctx, output.LaunchConfigurationTemplateID)
+	plan.keepUnconfiguredBlocksNull(prior)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, plan))
+}
+
+func (r *launchConfigurationTemplateResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data launchConfigurationTemplateResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.ID)
+	output, err := findLaunchConfigurationTemplateByID(ctx, conn, id)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	prior := data
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.keepUnconfiguredBlocksNull(prior)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *launchConfigurationTemplateResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old launchConfigurationTemplateResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &new))
+	if response.Diagnostics.HasError() {
+		return
+	}
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &old))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	diff, d := fwflex.Diff(ctx, new, old)
+	smerr.AddEnrich(ctx, &response.Diagnostics, d)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		id := fwflex.StringValueFromFramework(ctx, new.ID)
+		var input mgn.UpdateLaunchConfigurationTemplateInput
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, new, &input))
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.LaunchConfigurationTemplateID = aws.String(id)
+
+		output, err := conn.UpdateLaunchConfigurationTemplate(ctx, &input)
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+			return
+		}
+
+		prior := new
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &new))
+		if response.Diagnostics.HasError() {
+			return
+		}
+		new.keepUnconfiguredBlocksNull(prior)
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &new))
+}
+
+func (r *launchConfigurationTemplateResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data launchConfigurationTemplateResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.ID)
+	input := mgn.DeleteLaunchConfigurationTemplateInput{
+		LaunchConfigurationTemplateID: aws.String(id),
+	}
+	_, err := conn.DeleteLaunchConfigurationTemplate(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+}
+
+func findLaunchConfigurationTemplateByID(ctx context.Context, conn *mgn.Client, id string) (*awstypes.LaunchConfigurationTemplate, error) {
+	input := mgn.DescribeLaunchConfigurationTemplatesInput{
+		LaunchConfigurationTemplateIDs: []string{id},
+	}
+
+	return findLaunchConfigurationTemplate(ctx, conn, &input)
+}
+
+func findLaunchConfigurationTemplate(ctx context.Context, conn *mgn.Client, input *mgn.DescribeLaunchConfigurationTemplatesInput) (*awstypes.LaunchConfigurationTemplate, error) {
+	output, err := findLaunchConfigurationTemplates(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return smarterr.Assert(tfresource.AssertSingleValueResult(output))
+}
+
+func findLaunchConfigurationTemplates(ctx context.Context, conn *mgn.Client, input *mgn.DescribeLaunchConfigurationTemplatesInput) ([]awstypes.LaunchConfigurationTemplate, error) {
+	var output []awstypes.LaunchConfigurationTemplate
+
+	pages := mgn.NewDescribeLaunchConfigurationTemplatesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, smarterr.NewError(&retry.NotFoundError{
+				LastError: err,
+			})
+		}
+
+		if err != nil {
+			return nil, smarterr.NewError(err)
+		}
+
+		output = append(output, page.Items...)
+	}
+
+	return output, nil
+}
+
+type launchConfigurationTemplateResourceModel struct {
+	framework.WithRegionModel
+	ARN                                 types.String                                                     `tfsdk:"arn"`
+	AssociatePublicIPAddress            types.Bool                                                       `tfsdk:"associate_public_ip_address"`
+	BootMode                            fwtypes.StringEnum[awstypes.BootMode]                            `tfsdk:"boot_mode"`
+	CopyPrivateIP                       types.Bool                                                       `tfsdk:"copy_private_ip"`
+	CopyTags                            types.Bool                                                       `tfsdk:"copy_tags"`
+	EC2LaunchTemplateID                 types.String                                                     `tfsdk:"ec2_launch_template_id"`
+	EnableMapAutoTagging                types.Bool                                                       `tfsdk:"enable_map_auto_tagging"`
+	EnableParametersEncryption          types.Bool                                                       `tfsdk:"enable_parameters_encryption"`
+	ID                                  types.String                                                     `tfsdk:"id"`
+	LargeVolumeConf                     fwtypes.ListNestedObjectValueOf[launchTemplateDiskConfModel]     `tfsdk:"large_volume_conf"`
+	LaunchDisposition                   fwtypes.StringEnum[awstypes.LaunchDisposition]                   `tfsdk:"launch_disposition"`
+	Licensing                           fwtypes.ListNestedObjectValueOf[licensingModel]                  `tfsdk:"licensing"`
+	MapAutoTaggingMpeID                 types.String                                                     `tfsdk:"map_auto_tagging_mpe_id"`
+	ParametersEncryptionKey             fwtypes.ARN                                                      `tfsdk:"parameters_encryption_key"`
+	PostLaunchActions                   fwtypes.ListNestedObjectValueOf[postLaunchActionsModel]          `tfsdk:"post_launch_actions"`
+	SmallVolumeConf                     fwtypes.ListNestedObjectValueOf[launchTemplateDiskConfModel]     `tfsdk:"small_volume_conf"`
+	SmallVolumeMaxSize                  types.Int64                                                      `tfsdk:"small_volume_max_size"`
+	Tags                                tftags.Map                                                       `tfsdk:"tags"`
+	TagsAll                             tftags.Map                                                       `tfsdk:"tags_all"`
+	TargetInstanceTypeRightSizingMethod fwtypes.StringEnum[awstypes.TargetInstanceTypeRightSizingMethod] `tfsdk:"target_instance_type_right_sizing_method"`
+}
+
+// keepUnconfiguredBlocksNull discards the service defaults returned for blocks that are not configured.
+func (m *launchConfigurationTemplateResourceModel) keepUnconfiguredBlocksNull(prior launchConfigurationTemplateResourceModel) {
+	if len(prior.LargeVolumeConf.Elements()) == 0 {
+		m.LargeVolumeConf = prior.LargeVolumeConf
+	}
+	if len(prior.Licensing.Elements()) == 0 {
+		m.Licensing = prior.Licensing
+	}
+	if len(prior.PostLaunchActions.Elements()) == 0 {
+		m.PostLaunchActions = prior.PostLaunchActions
+	}
+	if len(prior.SmallVolumeConf.Elements()) == 0 {
+		m.SmallVolumeConf = prior.SmallVolumeConf
+	}
+}
+
+type launchTemplateDiskConfModel struct {
+	IOPS       types.Int64                             `tfsdk:"iops"`
+	Throughput types.Int64                             `tfsdk:"throughput"`
+	VolumeType fwtypes.StringEnum[awstypes.VolumeType] `tfsdk:"volume_type"`
+}
+
+type licensingModel struct {
+	OSBYOL types.Bool `tfsdk:"os_byol"`
+}
+
+type postLaunchActionsModel struct {
+	CloudWatchLogGroupName types.String                                                 `tfsdk:"cloud_watch_log_group_name"`
+	Deployment             fwtypes.StringEnum[awstypes.PostLaunchActionsDeploymentType] `tfsdk:"deployment"`
+	S3LogBucket            types.String                                                 `tfsdk:"s3_log_bucket"`
+	S3OutputKeyPrefix      types.String                                                 `tfsdk:"s3_output_key_prefix"`
+	SSMDocuments           fwtypes.ListNestedObjectValueOf[ssmDocumentModel]            `tfsdk:"ssm_document"`
+}
+
+type ssmDocumentModel struct {
+	ActionName            types.String `tfsdk:"action_name"`
+	MustSucceedForCutover types.Bool   `tfsdk:"must_succeed_for_cutover"`
+	SSMDocumentName       types.String `tfsdk:"ssm_document_name"`
+	TimeoutSeconds        types.Int32  `tfsdk:"timeout_seconds"`
+}
@@ -0,0 +1,258 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mgn/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfknownvalue "github.com/hashicorp/terraform-provider-aws/internal/acctest/knownvalue"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfmgn "github.com/hashicorp/terraform-provider-aws/internal/service/mgn"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMgnLaunchConfigurationTemplate_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.LaunchConfigurationTemplate
+	resourceName := "aws_mgn_launch_configuration_template.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MgnServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchConfigurationTemplateDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchConfigurationTemplateConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLaunchConfigurationTemplateExists(ctx, t, resourceName, &v),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrARN), tfknownvalue.RegionalARNRegexp("mgn", regexache.MustCompile(`launch-configuration-template/lct-.+$`))),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrID), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("large_volume_conf"), knownvalue.ListSizeExact(0)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("launch_disposition"), tfknownvalue.StringExact(awstypes.LaunchDispositionStopped)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("licensing"), knownvalue.ListSizeExact(0)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("post_launch_actions"), knownvalue.ListSizeExact(0)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("small_volume_conf"), knownvalue.ListSizeExact(0)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.Null()),
+				},
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"large_volume_conf", "licensing", "post_launch_actions", "small_volume_conf"},
+			},
+		},
+	})
+}
+
+func TestAccMgnLaunchConfigurationTemplate_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.LaunchConfigurationTemplate
+	resourceName := "aws_mgn_launch_configuration_template.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MgnServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchConfigurationTemplateDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchConfigurationTemplateConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLaunchConfigurationTemplateExists(ctx, t, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, t, tfmgn.ResourceLaunchConfigurationTemplate, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccMgnLaunchConfigurationTemplate_full(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.LaunchConfigurationTemplate
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_mgn_launch_configuration_template.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MgnServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchConfigurationTemplateDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchConfigurationTemplateConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLaunchConfigurationTemplateExists(ctx, t, resourceName, &v),
+				),
+			},
+			{
+				Config: testAccLaunchConfigurationTemplateConfig_full(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLaunchConfigurationTemplateExists(ctx, t, resourceName, &v),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("boot_mode"), tfknownvalue.StringExact(awstypes.BootModeUefi)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("copy_private_ip"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("copy_tags"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("large_volume_conf"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							names.AttrIOPS:       knownvalue.Int64Exact(3000),
+							names.AttrThroughput: knownvalue.Int64Exact(125),
+							names.AttrVolumeType: tfknownvalue.StringExact(awstypes.VolumeTypeGp3),
+						}),
+					})),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("launch_disposition"), tfknownvalue.StringExact(awstypes.LaunchDispositionStarted)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("licensing"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"os_byol": knownvalue.Bool(true),
+						}),
+					})),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("post_launch_actions"), knownvalue.ListSizeExact(1)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("post_launch_actions").AtSliceIndex(0).AtMapKey("deployment"), tfknownvalue.StringExact(awstypes.PostLaunchActionsDeploymentTypeTestAndCutover)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("post_launch_actions").AtSliceIndex(0).AtMapKey("ssm_document"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectPartial(map[string]knownvalue.Check{
+							"action_name":              knownvalue.StringExact(rName),
+							"must_succeed_for_cutover": knownvalue.Bool(true),
+							"ssm_document_name":        knownvalue.StringExact("AWS-RunShellScript"),
+							"timeout_seconds":          knownvalue.Int32Exact(120),
+						}),
+					})),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("target_instance_type_right_sizing_method"), tfknownvalue.StringExact(awstypes.TargetInstanceTypeRightSizingMethodBasic)),
+				},
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckLaunchConfigurationTemplateDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).MgnClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_mgn_launch_configuration_template" {
+				continue
+			}
+
+			_, err := tfmgn.FindLaunchConfigurationTemplateByID(ctx, conn, rs.Primary.Attributes[names.AttrID])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Mgn Launch Configuration Template %s still exists", rs.Primary.Attributes[names.AttrID])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckLaunchConfigurationTemplateExists(ctx context.Context, t *testing.T, n string, v *awstypes.LaunchConfigurationTemplate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).MgnClient(ctx)
+
+		output, err := tfmgn.FindLaunchConfigurationTemplateByID(ctx, conn, rs.Primary.Attributes[names.AttrID])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccLaunchConfigurationTemplateConfig_basic() string {
+	return `
+resource "aws_mgn_launch_configuration_template" "test" {
+  launch_disposition = "STOPPED"
+}
+`
+}
+
+func testAccLaunchConfigurationTemplateConfig_full(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_mgn_launch_configuration_template" "test" {
+  boot_mode                                = "UEFI"
+  copy_private_ip                          = true
+  copy_tags                                = true
+  launch_disposition                       = "STARTED"
+  target_instance_type_right_sizing_method = "BASIC"
+
+  large_volume_conf {
+    iops        = 3000
+    throughput  = 125
+    volume_type = "gp3"
+  }
+
+  licensing {
+    os_byol = true
+  }
+
+  post_launch_actions {
+    deployment = "TEST_AND_CUTOVER"
+
+    ssm_document {
+      action_name              = %[1]q
+      must_succeed_for_cutover = true
+      ssm_document_name        = "AWS-RunShellScript"
+      timeout_seconds          = 120
+    }
+  }
+}
+`, rName)
+}
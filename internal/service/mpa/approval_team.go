@@ -0,0 +1,440 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mpa
+
+import (
+	"context"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mpa"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mpa/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_mpa_approval_team", name="Approval Team")
+// @Tags(identifierAttribute="arn")
+// @ArnIdentity
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/mpa;mpa;mpa.GetApprovalTeamOutput")
+// @Testing(hasNoPreExistingResource=true)
+// @Testing(serialize=true)
+// @Testing(preCheck="github.com/hashicorp/terraform-provider-aws/internal/acctest;acctest.PreCheckSSOAdminInstances")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(identityRegionOverrideTest=false)
+func newApprovalTeamResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &approvalTeamResource{}
+
+	return r, nil
+}
+
+type approvalTeamResource struct {
+	framework.ResourceWithModel[approvalTeamResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *approvalTeamResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"number_of_approvers": schema.Int32Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ApprovalTeamStatus](),
+				Computed:   true,
+			},
+			names.AttrStatusCode: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ApprovalTeamStatusCode](),
+				Computed:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"version_id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"approval_strategy": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[approvalStrategyModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"m_of_n": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[mOfNApprovalStrategyModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"min_approvals_required": schema.Int32Attribute{
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"approver": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[approverModel](ctx),
+				Validators: []validator.Set{
+					setvalidator.IsRequired(),
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"primary_identity_id": schema.StringAttribute{
+							Required: true,
+						},
+						"primary_identity_source_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+					},
+				},
+			},
+			"policy": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[policyReferenceModel](ctx),
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Set{
+					setvalidator.IsRequired(),
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"policy_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *approvalTeamResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan approvalTeamResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MPAClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, plan.Name)
+	var input mpa.CreateApprovalTeamInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, plan, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(create.UniqueId(ctx))
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateApprovalTeam(ctx, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	// The new team remains PENDING until every approver has responded to the baseline session,
+	// so there is nothing to wait for here.
+	arn := aws.ToString(output.Arn)
+	approvalTeam, err := findApprovalTeamByARN(ctx, conn, arn)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, flattenApprovalTeam(ctx, approvalTeam, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, plan))
+}
+
+func (r *approvalTeamResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data approvalTeamResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MPAClient(ctx)
+
+	arn := fwflex.StringValueFromFramework(ctx, data.ARN)
+	output, err := findApprovalTeamByARN(ctx, conn, arn)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, flattenApprovalTeam(ctx, output, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *approvalTeamResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old approvalTeamResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &new))
+	if response.Diagnostics.HasError() {
+		return
+	}
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &old))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MPAClient(ctx)
+
+	diff, d := fwflex.Diff(ctx, new, old, fwflex.WithIgnoredField("Status"), fwflex.WithIgnoredField("StatusCode"), fwflex.WithIgnoredField("VersionID"))
+	smerr.AddEnrich(ctx, &response.Diagnostics, d)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	arn := fwflex.StringValueFromFramework(ctx, new.ARN)
+	if diff.HasChanges() {
+		var input mpa.UpdateApprovalTeamInput
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, new, &input))
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateApprovalTeam(ctx, &input)
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+	}
+
+	// Updates (including tag-only updates) may change the team's status and version.
+	output, err := findApprovalTeamByARN(ctx, conn, arn)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, flattenApprovalTeam(ctx, output, &new))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &new))
+}
+
+func (r *approvalTeamResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data approvalTeamResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MPAClient(ctx)
+
+	arn := fwflex.StringValueFromFramework(ctx, data.ARN)
+	var err error
+	if data.Status.ValueEnum() == awstypes.ApprovalTeamStatusActive {
+		// Active teams are deleted after a pending window during which the deletion must be approved.
+		input := mpa.StartActiveApprovalTeamDeletionInput{
+			Arn: aws.String(arn),
+		}
+		_, err = conn.StartActiveApprovalTeamDeletion(ctx, &input)
+	} else {
+		input := mpa.DeleteInactiveApprovalTeamVersionInput{
+			Arn:       aws.String(arn),
+			VersionId: fwflex.StringFromFramework(ctx, data.VersionID),
+		}
+		_, err = conn.DeleteInactiveApprovalTeamVersion(ctx, &input)
+	}
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+		return
+	}
+}
+
+func findApprovalTeamByARN(ctx context.Context, conn *mpa.Client, arn string) (*mpa.GetApprovalTeamOutput, error) {
+	input := mpa.GetApprovalTeamInput{
+		Arn: aws.String(arn),
+	}
+	output, err := conn.GetApprovalTeam(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError())
+	}
+
+	if status := output.Status; status == awstypes.ApprovalTeamStatusDeleting {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			Message: string(status),
+		})
+	}
+
+	return output, nil
+}
+
+// flattenApprovalTeam flattens the approval team into the resource model.
+// Any update awaiting approval takes precedence so that a pending change is not reported as drift.
+func flattenApprovalTeam(ctx context.Context, apiObject *mpa.GetApprovalTeamOutput, data *approvalTeamResourceModel) (diags diag.Diagnostics) {
+	diags.Append(fwflex.Flatten(ctx, apiObject, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if v := apiObject.PendingUpdate; v != nil {
+		diags.Append(fwflex.Flatten(ctx, v, data)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
+}
+
+type approvalTeamResourceModel struct {
+	framework.WithRegionModel
+	ApprovalStrategy  fwtypes.ListNestedObjectValueOf[approvalStrategyModel] `tfsdk:"approval_strategy"`
+	Approvers         fwtypes.SetNestedObjectValueOf[approverModel]          `tfsdk:"approver"`
+	ARN               types.String                                           `tfsdk:"arn"`
+	Description       types.String                                           `tfsdk:"description"`
+	Name              types.String                                           `tfsdk:"name"`
+	NumberOfApprovers types.Int32                                            `tfsdk:"number_of_approvers"`
+	Policies          fwtypes.SetNestedObjectValueOf[policyReferenceModel]   `tfsdk:"policy"`
+	Status            fwtypes.StringEnum[awstypes.ApprovalTeamStatus]        `tfsdk:"status"`
+	StatusCode        fwtypes.StringEnum[awstypes.ApprovalTeamStatusCode]    `tfsdk:"status_code"`
+	Tags              tftags.Map                                             `tfsdk:"tags"`
+	TagsAll           tftags.Map                                             `tfsdk:"tags_all"`
+	VersionID         types.String                                           `tfsdk:"version_id"`
+}
+
+type approvalStrategyModel struct {
+	MofN fwtypes.ListNestedObjectValueOf[mOfNApprovalStrategyModel] `tfsdk:"m_of_n"`
+}
+
+var (
+	_ fwflex.Expander  = approvalStrategyModel{}
+	_ fwflex.Flattener = &approvalStrategyModel{}
+)
+
+func (m approvalStrategyModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.MofN.IsNull():
+		mOfNApprovalStrategyData, d := m.MofN.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.ApprovalStrategyMemberMofN
+		diags.Append(fwflex.Expand(ctx, mOfNApprovalStrategyData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *approvalStrategyModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.ApprovalStrategyResponseMemberMofN:
+		var model mOfNApprovalStrategyModel
+		d := fwflex.Flatten(ctx, t.Value, &model)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.MofN = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+	}
+
+	return diags
+}
+
+type mOfNApprovalStrategyModel struct {
+	MinApprovalsRequired types.Int32 `tfsdk:"min_approvals_required"`
+}
+
+type approverModel struct {
+	PrimaryIdentityID        types.String `tfsdk:"primary_identity_id"`
+	PrimaryIdentitySourceARN fwtypes.ARN  `tfsdk:"primary_identity_source_arn"`
+}
+
+type policyReferenceModel struct {
+	PolicyARN fwtypes.ARN `tfsdk:"policy_arn"`
+}
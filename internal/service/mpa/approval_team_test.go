@@ -0,0 +1,311 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mpa_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/mpa"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mpa/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfknownvalue "github.com/hashicorp/terraform-provider-aws/internal/acctest/knownvalue"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfmpa "github.com/hashicorp/terraform-provider-aws/internal/service/mpa"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// New approval teams remain PENDING until each approver has responded to the baseline session,
+// so these tests only exercise teams that have not yet been activated.
+
+func TestAccMPAApprovalTeam_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mpa.GetApprovalTeamOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_mpa_approval_team.test"
+
+	acctest.Test(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MPAServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApprovalTeamDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApprovalTeamConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckApprovalTeamExists(ctx, t, resourceName, &v),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("approval_strategy"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"m_of_n": knownvalue.ListExact([]knownvalue.Check{
+								knownvalue.ObjectExact(map[string]knownvalue.Check{
+									"min_approvals_required": knownvalue.Int32Exact(1),
+								}),
+							}),
+						}),
+					})),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("approver"), knownvalue.SetSizeExact(2)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrARN), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrDescription), knownvalue.StringExact("Test approval team")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrName), knownvalue.StringExact(rName)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("number_of_approvers"), knownvalue.Int32Exact(2)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("policy"), knownvalue.SetSizeExact(1)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrStatus), tfknownvalue.StringExact(awstypes.ApprovalTeamStatusPending)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.Null()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("version_id"), knownvalue.NotNull()),
+				},
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrARN),
+				ImportStateVerifyIdentifierAttribute: names.AttrARN,
+			},
+		},
+	})
+}
+
+func TestAccMPAApprovalTeam_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mpa.GetApprovalTeamOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_mpa_approval_team.test"
+
+	acctest.Test(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MPAServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApprovalTeamDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApprovalTeamConfig_updated(rName, "Test approval team", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckApprovalTeamExists(ctx, t, resourceName, &v),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("approval_strategy").AtSliceIndex(0).AtMapKey("m_of_n").AtSliceIndex(0).AtMapKey("min_approvals_required"), knownvalue.Int32Exact(1)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrDescription), knownvalue.StringExact("Test approval team")),
+				},
+			},
+			{
+				Config: testAccApprovalTeamConfig_updated(rName, "Updated test approval team", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckApprovalTeamExists(ctx, t, resourceName, &v),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("approval_strategy").AtSliceIndex(0).AtMapKey("m_of_n").AtSliceIndex(0).AtMapKey("min_approvals_required"), knownvalue.Int32Exact(2)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrDescription), knownvalue.StringExact("Updated test approval team")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("number_of_approvers"), knownvalue.Int32Exact(2)),
+				},
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrARN),
+				ImportStateVerifyIdentifierAttribute: names.AttrARN,
+			},
+		},
+	})
+}
+
+func TestAccMPAApprovalTeam_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mpa.GetApprovalTeamOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_mpa_approval_team.test"
+
+	acctest.Test(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MPAServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApprovalTeamDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApprovalTeamConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckApprovalTeamExists(ctx, t, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, t, tfmpa.ResourceApprovalTeam, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckApprovalTeamDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).MPAClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_mpa_approval_team" {
+				continue
+			}
+
+			_, err := tfmpa.FindApprovalTeamByARN(ctx, conn, rs.Primary.Attributes[names.AttrARN])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("MPA Approval Team %s still exists", rs.Primary.Attributes[names.AttrARN])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckApprovalTeamExists(ctx context.Context, t *testing.T, n string, v *mpa.GetApprovalTeamOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).MPAClient(ctx)
+
+		output, err := tfmpa.FindApprovalTeamByARN(ctx, conn, rs.Primary.Attributes[names.AttrARN])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccApprovalTeamConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccIdentitySourceConfig_basic(), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_identitystore_user" "test" {
+  count = 2
+
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+
+  display_name = "Acceptance Test"
+  user_name    = "%[1]s-${count.index}"
+
+  name {
+    family_name = "Doe"
+    given_name  = "John"
+  }
+}
+
+resource "aws_mpa_approval_team" "test" {
+  name        = %[1]q
+  description = "Test approval team"
+
+  approval_strategy {
+    m_of_n {
+      min_approvals_required = 1
+    }
+  }
+
+  dynamic "approver" {
+    for_each = aws_identitystore_user.test
+
+    content {
+      primary_identity_id         = approver.value.user_id
+      primary_identity_source_arn = aws_mpa_identity_source.test.arn
+    }
+  }
+
+  policy {
+    policy_arn = "arn:${data.aws_partition.current.partition}:mpa::aws:policy/backup.amazonaws.com/CreateRestoreAccessVault"
+  }
+}
+`, rName))
+}
+
+func testAccApprovalTeamConfig_updated(rName, description string, minApprovalsRequired int) string {
+	return acctest.ConfigCompose(testAccIdentitySourceConfig_basic(), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_identitystore_user" "test" {
+  count = 2
+
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+
+  display_name = "Acceptance Test"
+  user_name    = "%[1]s-${count.index}"
+
+  name {
+    family_name = "Doe"
+    given_name  = "John"
+  }
+}
+
+resource "aws_mpa_approval_team" "test" {
+  name        = %[1]q
+  description = %[2]q
+
+  approval_strategy {
+    m_of_n {
+      min_approvals_required = %[3]d
+    }
+  }
+
+  dynamic "approver" {
+    for_each = aws_identitystore_user.test
+
+    content {
+      primary_identity_id         = approver.value.user_id
+      primary_identity_source_arn = aws_mpa_identity_source.test.arn
+    }
+  }
+
+  policy {
+    policy_arn = "arn:${data.aws_partition.current.partition}:mpa::aws:policy/backup.amazonaws.com/CreateRestoreAccessVault"
+  }
+}
+`, rName, description, minApprovalsRequired))
+}
@@ -0,0 +1,28 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mpa_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+// Approval teams and identity sources are scoped to the account's single IAM Identity Center instance.
+func TestAccMPA_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]map[string]func(t *testing.T){
+		"ApprovalTeam": {
+			"tags":     testAccMPAApprovalTeam_tagsSerial,
+			"Identity": testAccMPAApprovalTeam_identitySerial,
+		},
+		"IdentitySource": {
+			"tags":     testAccMPAIdentitySource_tagsSerial,
+			"Identity": testAccMPAIdentitySource_identitySerial,
+		},
+	}
+
+	acctest.RunSerialTests2Levels(t, testCases, 0)
+}
@@ -0,0 +1,63 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_mpa_approval_team" "test" {
+  name        = var.rName
+  description = "Test approval team"
+
+  approval_strategy {
+    m_of_n {
+      min_approvals_required = 1
+    }
+  }
+
+  dynamic "approver" {
+    for_each = aws_identitystore_user.test
+
+    content {
+      primary_identity_id         = approver.value.user_id
+      primary_identity_source_arn = aws_mpa_identity_source.test.arn
+    }
+  }
+
+  policy {
+    policy_arn = "arn:${data.aws_partition.current.partition}:mpa::aws:policy/backup.amazonaws.com/CreateRestoreAccessVault"
+  }
+}
+
+resource "aws_identitystore_user" "test" {
+  count = 2
+
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+
+  display_name = "Acceptance Test"
+  user_name    = "${var.rName}-${count.index}"
+
+  name {
+    family_name = "Doe"
+    given_name  = "John"
+  }
+}
+
+resource "aws_mpa_identity_source" "test" {
+  identity_source_parameters {
+    iam_identity_center {
+      instance_arn = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+      region       = data.aws_region.current.region
+    }
+  }
+}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {
+}
+
+data "aws_ssoadmin_instances" "test" {
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
@@ -0,0 +1,71 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_mwaaserverless_workflow" "test" {
+  name     = var.rName
+  role_arn = aws_iam_role.test.arn
+
+  definition_s3_location {
+    bucket     = aws_s3_object.test.bucket
+    object_key = aws_s3_object.test.key
+  }
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = var.rName
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  key    = "workflow.yaml"
+  content = yamlencode({
+    simple_dag = {
+      dag_id   = "simple_dag"
+      schedule = null
+      tasks = {
+        list_buckets = {
+          operator = "airflow.providers.amazon.aws.operators.s3.S3ListOperator"
+          bucket   = aws_s3_bucket.test.bucket
+        }
+      }
+    }
+  })
+}
+
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = var.rName
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "airflow-serverless.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = var.rName
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = ["s3:GetObject", "s3:ListBucket"]
+      Effect   = "Allow"
+      Resource = [aws_s3_bucket.test.arn, "${aws_s3_bucket.test.arn}/*"]
+    }]
+  })
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
@@ -0,0 +1,414 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mwaaserverless
+
+import (
+	"context"
+	"time"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mwaaserverless"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mwaaserverless/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_mwaaserverless_workflow", name="Workflow")
+// @Tags(identifierAttribute="arn")
+// @ArnIdentity
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/mwaaserverless;mwaaserverless;mwaaserverless.GetWorkflowOutput")
+// @Testing(hasNoPreExistingResource=true)
+func newWorkflowResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &workflowResource{}
+
+	r.SetDefaultDeleteTimeout(10 * time.Minute)
+
+	return r, nil
+}
+
+type workflowResource struct {
+	framework.ResourceWithModel[workflowResourceModel]
+	framework.WithTimeouts
+	framework.WithImportByIdentity
+}
+
+func (r *workflowResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN:         framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{Optional: true},
+			"encryption_configuration": framework.ResourceOptionalComputedListOfObjectsAttribute[encryptionConfigurationModel](ctx, 1, nil,
+				listplanmodifier.RequiresReplaceIfConfigured(),
+				listplanmodifier.UseStateForUnknown(),
+			),
+			names.AttrEngineVersion: schema.Int32Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"trigger_mode": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workflow_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.WorkflowStatus](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workflow_version": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"definition_s3_location": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[definitionS3LocationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrBucket: schema.StringAttribute{
+							Required: true,
+						},
+						"object_key": schema.StringAttribute{
+							Required: true,
+						},
+						"version_id": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			"logging_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[loggingConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrLogGroupName: schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			names.AttrNetworkConfiguration: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[networkConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrSecurityGroupIDs: schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						names.AttrSubnetIDs: schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *workflowResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan workflowResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MWAAServerlessClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, plan.Name)
+	var input mwaaserverless.CreateWorkflowInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, plan, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateWorkflow(ctx, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	arn := aws.ToString(output.WorkflowArn)
+	workflow, err := findWorkflowByARN(ctx, conn, arn)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, workflow, &plan, fwflex.WithFieldNamePrefix("Workflow")))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, plan))
+}
+
+func (r *workflowResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data workflowResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MWAAServerlessClient(ctx)
+
+	arn := fwflex.StringValueFromFramework(ctx, data.ARN)
+	output, err := findWorkflowByARN(ctx, conn, arn)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &data, fwflex.WithFieldNamePrefix("Workflow")))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *workflowResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old workflowResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &new))
+	if response.Diagnostics.HasError() {
+		return
+	}
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &old))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MWAAServerlessClient(ctx)
+
+	diff, d := fwflex.Diff(ctx, new, old, fwflex.WithIgnoredField("WorkflowVersion"))
+	smerr.AddEnrich(ctx, &response.Diagnostics, d)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	arn := fwflex.StringValueFromFramework(ctx, new.ARN)
+	if diff.HasChanges() {
+		var input mwaaserverless.UpdateWorkflowInput
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, new, &input))
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.WorkflowArn = aws.String(arn)
+
+		_, err := conn.UpdateWorkflow(ctx, &input)
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+
+		output, err := findWorkflowByARN(ctx, conn, arn)
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &new, fwflex.WithFieldNamePrefix("Workflow")))
+		if response.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		new.WorkflowVersion = old.WorkflowVersion
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &new))
+}
+
+func (r *workflowResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data workflowResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MWAAServerlessClient(ctx)
+
+	arn := fwflex.StringValueFromFramework(ctx, data.ARN)
+	input := mwaaserverless.DeleteWorkflowInput{
+		WorkflowArn: aws.String(arn),
+	}
+	_, err := conn.DeleteWorkflow(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+		return
+	}
+
+	if _, err := waitWorkflowDeleted(ctx, conn, arn, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+		return
+	}
+}
+
+func findWorkflowByARN(ctx context.Context, conn *mwaaserverless.Client, arn string) (*mwaaserverless.GetWorkflowOutput, error) {
+	input := mwaaserverless.GetWorkflowInput{
+		WorkflowArn: aws.String(arn),
+	}
+	output, err := conn.GetWorkflow(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError())
+	}
+
+	return output, nil
+}
+
+func statusWorkflow(conn *mwaaserverless.Client, arn string) retry.StateRefreshFunc {
+	return func(ctx context.Context) (any, string, error) {
+		output, err := findWorkflowByARN(ctx, conn, arn)
+
+		if retry.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.WorkflowStatus), nil
+	}
+}
+
+func waitWorkflowDeleted(ctx context.Context, conn *mwaaserverless.Client, arn string, timeout time.Duration) (*mwaaserverless.GetWorkflowOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.WorkflowStatusReady, awstypes.WorkflowStatusDeleting),
+		Target:  []string{},
+		Refresh: statusWorkflow(conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*mwaaserverless.GetWorkflowOutput); ok {
+		return output, smarterr.NewError(err)
+	}
+
+	return nil, smarterr.NewError(err)
+}
+
+type workflowResourceModel struct {
+	framework.WithRegionModel
+	ARN                     types.String                                                  `tfsdk:"arn"`
+	DefinitionS3Location    fwtypes.ListNestedObjectValueOf[definitionS3LocationModel]    `tfsdk:"definition_s3_location"`
+	Description             types.String                                                  `tfsdk:"description"`
+	EncryptionConfiguration fwtypes.ListNestedObjectValueOf[encryptionConfigurationModel] `tfsdk:"encryption_configuration"`
+	EngineVersion           types.Int32                                                   `tfsdk:"engine_version"`
+	LoggingConfiguration    fwtypes.ListNestedObjectValueOf[loggingConfigurationModel]    `tfsdk:"logging_configuration"`
+	Name                    types.String                                                  `tfsdk:"name"`
+	NetworkConfiguration    fwtypes.ListNestedObjectValueOf[networkConfigurationModel]    `tfsdk:"network_configuration"`
+	RoleARN                 fwtypes.ARN                                                   `tfsdk:"role_arn"`
+	Tags                    tftags.Map                                                    `tfsdk:"tags"`
+	TagsAll                 tftags.Map                                                    `tfsdk:"tags_all"`
+	Timeouts                timeouts.Value                                                `tfsdk:"timeouts"`
+	TriggerMode             types.String                                                  `tfsdk:"trigger_mode"`
+	WorkflowStatus          fwtypes.StringEnum[awstypes.WorkflowStatus]                   `tfsdk:"workflow_status"`
+	WorkflowVersion         types.String                                                  `tfsdk:"workflow_version"`
+}
+
+type definitionS3LocationModel struct {
+	Bucket    types.String `tfsdk:"bucket"`
+	ObjectKey types.String `tfsdk:"object_key"`
+	VersionID types.String `tfsdk:"version_id"`
+}
+
+type encryptionConfigurationModel struct {
+	KMSKeyID types.String                                `tfsdk:"kms_key_id"`
+	Type     fwtypes.StringEnum[awstypes.EncryptionType] `tfsdk:"type"`
+}
+
+type loggingConfigurationModel struct {
+	LogGroupName types.String `tfsdk:"log_group_name"`
+}
+
+type networkConfigurationModel struct {
+	SecurityGroupIDs fwtypes.SetOfString `tfsdk:"security_group_ids"`
+	SubnetIDs        fwtypes.SetOfString `tfsdk:"subnet_ids"`
+}
@@ -0,0 +1,124 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mwaaserverless
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mwaaserverless"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mwaaserverless/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkListResource("aws_mwaaserverless_workflow")
+func newWorkflowResourceAsListResource() list.ListResourceWithConfigure {
+	return &workflowListResource{}
+}
+
+var _ list.ListResource = &workflowListResource{}
+
+type workflowListResource struct {
+	workflowResource
+	framework.WithList
+}
+
+func (r *workflowListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var query workflowListModel
+
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	awsClient := r.Meta()
+	conn := awsClient.MWAAServerlessClient(ctx)
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		result := request.NewListResult(ctx)
+		var input mwaaserverless.ListWorkflowsInput
+		for item, err := range listWorkflows(ctx, conn, &input) {
+			if err != nil {
+				result = fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			if item.WorkflowStatus == awstypes.WorkflowStatusDeleting {
+				continue
+			}
+
+			arn := aws.ToString(item.WorkflowArn)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrARN), arn)
+
+			// ListWorkflows returns only a summary of each workflow.
+			workflow, err := findWorkflowByARN(ctx, conn, arn)
+
+			if retry.NotFound(err) {
+				tflog.Warn(ctx, "MWAA Serverless Workflow not found during listing")
+				continue
+			}
+
+			if err != nil {
+				result = fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			var data workflowResourceModel
+			r.SetResult(ctx, awsClient, request.IncludeResource, &data, &result, func() {
+				if diags := fwflex.Flatten(ctx, workflow, &data, fwflex.WithFieldNamePrefix("Workflow")); diags.HasError() {
+					result.Diagnostics.Append(diags...)
+					return
+				}
+
+				result.DisplayName = aws.ToString(workflow.Name)
+			})
+
+			if result.Diagnostics.HasError() {
+				result = list.ListResult{Diagnostics: result.Diagnostics}
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+type workflowListModel struct {
+	framework.WithRegionModel
+}
+
+func listWorkflows(ctx context.Context, conn *mwaaserverless.Client, input *mwaaserverless.ListWorkflowsInput) iter.Seq2[awstypes.WorkflowSummary, error] {
+	return func(yield func(awstypes.WorkflowSummary, error) bool) {
+		pages := mwaaserverless.NewListWorkflowsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.WorkflowSummary{}, fmt.Errorf("listing MWAA Serverless Workflows: %w", err))
+				return
+			}
+
+			for _, workflow := range page.Workflows {
+				if !yield(workflow, nil) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -47,6 +47,13 @@ import (
 // Function annotations are used for resource registration to the Provider. DO NOT EDIT.
 // @FrameworkResource("aws_odb_cloud_autonomous_vm_cluster", name="Cloud Autonomous Vm Cluster")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("id")
+// @Testing(preIdentityVersion="v6.41.0")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/odb/types;odbtypes;odbtypes.CloudAutonomousVmCluster")
+// @Testing(existsTakesT=false, destroyTakesT=false)
+// @Testing(preCheck="autonomousVMClusterResourceTestEntity.testAccPreCheck")
+// @Testing(emailAddress="address")
+// @Testing(identityRegionOverrideTest=false)
 func newResourceCloudAutonomousVmCluster(_ context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourceCloudAutonomousVmCluster{}
 	r.SetDefaultCreateTimeout(24 * time.Hour)
@@ -63,7 +70,7 @@ const (
 type resourceCloudAutonomousVmCluster struct {
 	framework.ResourceWithModel[cloudAutonomousVmClusterResourceModel]
 	framework.WithTimeouts
-	framework.WithImportByID
+	framework.WithImportByIdentity
 }
 
 func (r *resourceCloudAutonomousVmCluster) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -202,7 +209,7 @@ func (r *resourceCloudAutonomousVmCluster) Schema(ctx context.Context, req resou
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
-				Description: "The description of the Autonomous VM cluster.",
+				Description: "The description of the Autonomous VM cluster. Changing this will force terraform to create new resource.",
 			},
 			names.AttrDisplayName: schema.StringAttribute{
 				Required:   true,
@@ -59,12 +59,12 @@ func TestAccODBCloudAutonomousVmCluster_basic(t *testing.T) {
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.ODBServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             autonomousVMClusterResourceTestEntity.testAccCheckCloudAutonomousVmClusterDestroy(ctx),
+		CheckDestroy:             testAccCheckCloudAutonomousVmClusterDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
 				Config: autonomousVMClusterResourceTestEntity.avmcBasic(),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					autonomousVMClusterResourceTestEntity.checkCloudAutonomousVmClusterExists(ctx, resourceName, &cloudAVMC),
+					testAccCheckCloudAutonomousVmClusterExists(ctx, resourceName, &cloudAVMC),
 				),
 			},
 			{
@@ -91,7 +91,7 @@ func TestAccODBCloudAutonomousVmCluster_variables(t *testing.T) {
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.ODBServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             autonomousVMClusterResourceTestEntity.testAccCheckCloudAutonomousVmClusterDestroy(ctx),
+		CheckDestroy:             testAccCheckCloudAutonomousVmClusterDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
 				Config: autonomousVmClusterConfig_useVariables(avmcDisplayName),
@@ -123,7 +123,7 @@ func TestAccODBCloudAutonomousVmCluster_usingARN(t *testing.T) {
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.ODBServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             autonomousVMClusterResourceTestEntity.testAccCheckCloudAutonomousVmClusterDestroy(ctx),
+		CheckDestroy:             testAccCheckCloudAutonomousVmClusterDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
 				Config: avmcWithoutTag,
@@ -131,7 +131,7 @@ func TestAccODBCloudAutonomousVmCluster_usingARN(t *testing.T) {
 					resource.ComposeTestCheckFunc(func(state *terraform.State) error {
 						return nil
 					}),
-					autonomousVMClusterResourceTestEntity.checkCloudAutonomousVmClusterExists(ctx, resourceName, &avmc1),
+					testAccCheckCloudAutonomousVmClusterExists(ctx, resourceName, &avmc1),
 				),
 			},
 			{
@@ -142,7 +142,7 @@ func TestAccODBCloudAutonomousVmCluster_usingARN(t *testing.T) {
 			{
 				Config: avmcWithTag,
 				Check: resource.ComposeAggregateTestCheckFunc(
-					autonomousVMClusterResourceTestEntity.checkCloudAutonomousVmClusterExists(ctx, resourceName, &avmc2),
+					testAccCheckCloudAutonomousVmClusterExists(ctx, resourceName, &avmc2),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
 					resource.TestCheckResourceAttr(resourceName, "tags.env", "dev"),
 					resource.ComposeTestCheckFunc(func(state *terraform.State) error {
@@ -175,12 +175,12 @@ func TestAccODBCloudAutonomousVmCluster_withAllParams(t *testing.T) {
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.ODBServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             autonomousVMClusterResourceTestEntity.testAccCheckCloudAutonomousVmClusterDestroy(ctx),
+		CheckDestroy:             testAccCheckCloudAutonomousVmClusterDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
 				Config: autonomousVMClusterResourceTestEntity.avmcAllParamsConfig(),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					autonomousVMClusterResourceTestEntity.checkCloudAutonomousVmClusterExists(ctx, resourceName, &cloudAVMC),
+					testAccCheckCloudAutonomousVmClusterExists(ctx, resourceName, &cloudAVMC),
 				),
 			},
 			{
@@ -208,14 +208,14 @@ func TestAccODBCloudAutonomousVmCluster_tagging(t *testing.T) {
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.ODBServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             autonomousVMClusterResourceTestEntity.testAccCheckCloudAutonomousVmClusterDestroy(ctx),
+		CheckDestroy:             testAccCheckCloudAutonomousVmClusterDestroy(ctx),
 
 		Steps: []resource.TestStep{
 			{
 				Config: withoutTag,
 
 				Check: resource.ComposeAggregateTestCheckFunc(
-					autonomousVMClusterResourceTestEntity.checkCloudAutonomousVmClusterExists(ctx, resourceName, &avmc1),
+					testAccCheckCloudAutonomousVmClusterExists(ctx, resourceName, &avmc1),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
 				),
 			},
@@ -227,7 +227,7 @@ func TestAccODBCloudAutonomousVmCluster_tagging(t *testing.T) {
 			{
 				Config: withTag,
 				Check: resource.ComposeAggregateTestCheckFunc(
-					autonomousVMClusterResourceTestEntity.checkCloudAutonomousVmClusterExists(ctx, resourceName, &avmc2),
+					testAccCheckCloudAutonomousVmClusterExists(ctx, resourceName, &avmc2),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
 					resource.TestCheckResourceAttr(resourceName, "tags.env", "dev"),
 					resource.ComposeTestCheckFunc(func(state *terraform.State) error {
@@ -257,12 +257,12 @@ func TestAccODBCloudAutonomousVmCluster_disappears(t *testing.T) {
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.ODBServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             autonomousVMClusterResourceTestEntity.testAccCheckCloudAutonomousVmClusterDestroy(ctx),
+		CheckDestroy:             testAccCheckCloudAutonomousVmClusterDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
 				Config: autonomousVMClusterResourceTestEntity.avmcBasic(),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					autonomousVMClusterResourceTestEntity.checkCloudAutonomousVmClusterExists(ctx, resourceName, &cloudautonomousvmcluster),
+					testAccCheckCloudAutonomousVmClusterExists(ctx, resourceName, &cloudautonomousvmcluster),
 					acctest.CheckFrameworkResourceDisappears(ctx, t, tfodb.ResourceCloudAutonomousVMCluster, resourceName),
 				),
 				ExpectNonEmptyPlan: true,
@@ -282,7 +282,7 @@ func (autonomousVMClusterResourceTest) testAccPreCheck(ctx context.Context, t *t
 		t.Fatalf("unexpected PreCheck error: %s", err)
 	}
 }
-func (autonomousVMClusterResourceTest) testAccCheckCloudAutonomousVmClusterDestroy(ctx context.Context) resource.TestCheckFunc {
+func testAccCheckCloudAutonomousVmClusterDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).ODBClient(ctx)
 
@@ -306,7 +306,7 @@ func (autonomousVMClusterResourceTest) testAccCheckCloudAutonomousVmClusterDestr
 	}
 }
 
-func (autonomousVMClusterResourceTest) checkCloudAutonomousVmClusterExists(ctx context.Context, name string, cloudAutonomousVMCluster *odbtypes.CloudAutonomousVmCluster) resource.TestCheckFunc {
+func testAccCheckCloudAutonomousVmClusterExists(ctx context.Context, name string, cloudAutonomousVMCluster *odbtypes.CloudAutonomousVmCluster) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
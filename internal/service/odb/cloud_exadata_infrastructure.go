@@ -42,6 +42,12 @@ import (
 // Function annotations are used for resource registration to the Provider. DO NOT EDIT.
 // @FrameworkResource("aws_odb_cloud_exadata_infrastructure", name="Cloud Exadata Infrastructure")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("id")
+// @Testing(preIdentityVersion="v6.41.0")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/odb/types;odbtypes;odbtypes.CloudExadataInfrastructure")
+// @Testing(existsTakesT=false, destroyTakesT=false)
+// @Testing(preCheck="exaInfraTestResource.testAccPreCheck")
+// @Testing(identityRegionOverrideTest=false)
 func newResourceCloudExadataInfrastructure(_ context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourceCloudExadataInfrastructure{}
 
@@ -59,7 +65,7 @@ const (
 type resourceCloudExadataInfrastructure struct {
 	framework.ResourceWithModel[cloudExadataInfrastructureResourceModel]
 	framework.WithTimeouts
-	framework.WithImportByID
+	framework.WithImportByIdentity
 }
 
 func (r *resourceCloudExadataInfrastructure) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package odb
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkListResource("aws_odb_cloud_exadata_infrastructure")
+func newCloudExadataInfrastructureResourceAsListResource() list.ListResourceWithConfigure {
+	return &listResourceCloudExadataInfrastructure{}
+}
+
+var _ list.ListResource = &listResourceCloudExadataInfrastructure{}
+
+type listResourceCloudExadataInfrastructure struct {
+	resourceCloudExadataInfrastructure
+	framework.WithList
+}
+
+func (r *listResourceCloudExadataInfrastructure) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	conn := r.Meta().ODBClient(ctx)
+
+	var query cloudExadataInfrastructureListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		result := request.NewListResult(ctx)
+		output, err := ListCloudExadataInfrastructures(ctx, conn)
+		if err != nil {
+			result = fwdiag.NewListResultErrorDiagnostic(err)
+			yield(result)
+			return
+		}
+
+		for _, summary := range output.CloudExadataInfrastructures {
+			id := aws.ToString(summary.CloudExadataInfrastructureId)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), id)
+
+			exadataInfra, err := findExadataInfraResourceByID(ctx, conn, id)
+			if retry.NotFound(err) {
+				tflog.Warn(ctx, "ODB Cloud Exadata Infrastructure not found during listing")
+				continue
+			}
+			if err != nil {
+				tflog.Error(ctx, "Reading ODB Cloud Exadata Infrastructure", map[string]any{"error": err.Error()})
+				continue
+			}
+
+			var data cloudExadataInfrastructureResourceModel
+			r.SetResult(ctx, r.Meta(), request.IncludeResource, &data, &result, func() {
+				result.Diagnostics.Append(flex.Flatten(ctx, exadataInfra, &data)...)
+				if result.Diagnostics.HasError() {
+					return
+				}
+
+				result.DisplayName = aws.ToString(exadataInfra.DisplayName)
+			})
+
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+type cloudExadataInfrastructureListResourceModel struct {
+	framework.WithRegionModel
+}
@@ -50,12 +50,12 @@ func TestAccODBCloudExadataInfrastructureResource_basic(t *testing.T) {
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.ODBServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             exaInfraTestResource.testAccCheckCloudExaDataInfraDestroyed(ctx),
+		CheckDestroy:             testAccCheckCloudExadataInfrastructureDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
 				Config: exaInfraTestResource.exaDataInfraResourceBasicConfig(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					exaInfraTestResource.testAccCheckCloudExadataInfrastructureExists(ctx, resourceName, &cloudExaDataInfrastructure),
+					testAccCheckCloudExadataInfrastructureExists(ctx, resourceName, &cloudExaDataInfrastructure),
 				),
 			},
 			{
@@ -86,12 +86,12 @@ func TestAccODBCloudExadataInfrastructureResource_withAllParameters(t *testing.T
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.ODBServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             exaInfraTestResource.testAccCheckCloudExaDataInfraDestroyed(ctx),
+		CheckDestroy:             testAccCheckCloudExadataInfrastructureDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
 				Config: exaInfraTestResource.exaDataInfraResourceWithAllConfig(rName, emailAddress1, emailAddress2),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					exaInfraTestResource.testAccCheckCloudExadataInfrastructureExists(ctx, resourceName, &cloudExaDataInfrastructure),
+					testAccCheckCloudExadataInfrastructureExists(ctx, resourceName, &cloudExaDataInfrastructure),
 				),
 			},
 			{
@@ -120,12 +120,12 @@ func TestAccODBCloudExadataInfrastructureResource_tagging(t *testing.T) {
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.ODBServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             exaInfraTestResource.testAccCheckCloudExaDataInfraDestroyed(ctx),
+		CheckDestroy:             testAccCheckCloudExadataInfrastructureDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
 				Config: exaInfraTestResource.exaDataInfraResourceBasicConfigWithTags(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					exaInfraTestResource.testAccCheckCloudExadataInfrastructureExists(ctx, resourceName, &cloudExaDataInfrastructure1),
+					testAccCheckCloudExadataInfrastructureExists(ctx, resourceName, &cloudExaDataInfrastructure1),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
 					resource.TestCheckResourceAttr(resourceName, "tags.env", "dev"),
 				),
@@ -138,7 +138,7 @@ func TestAccODBCloudExadataInfrastructureResource_tagging(t *testing.T) {
 			{
 				Config: exaInfraTestResource.exaDataInfraResourceBasicConfig(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					exaInfraTestResource.testAccCheckCloudExadataInfrastructureExists(ctx, resourceName, &cloudExaDataInfrastructure2),
+					testAccCheckCloudExadataInfrastructureExists(ctx, resourceName, &cloudExaDataInfrastructure2),
 					resource.ComposeTestCheckFunc(func(state *terraform.State) error {
 						if strings.Compare(*(cloudExaDataInfrastructure1.CloudExadataInfrastructureId), *(cloudExaDataInfrastructure2.CloudExadataInfrastructureId)) != 0 {
 							return errors.New("Should not  create a new cloud exa basicExaInfraDataSource after  update")
@@ -174,12 +174,12 @@ func TestAccODBCloudExadataInfrastructureResource_updateDisplayName(t *testing.T
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.ODBServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             exaInfraTestResource.testAccCheckCloudExaDataInfraDestroyed(ctx),
+		CheckDestroy:             testAccCheckCloudExadataInfrastructureDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
 				Config: exaInfraTestResource.exaDataInfraResourceBasicConfig(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					exaInfraTestResource.testAccCheckCloudExadataInfrastructureExists(ctx, resourceName, &cloudExaDataInfrastructure1),
+					testAccCheckCloudExadataInfrastructureExists(ctx, resourceName, &cloudExaDataInfrastructure1),
 				),
 			},
 			{
@@ -190,7 +190,7 @@ func TestAccODBCloudExadataInfrastructureResource_updateDisplayName(t *testing.T
 			{
 				Config: exaInfraTestResource.exaDataInfraResourceBasicConfig(rName + "-u"),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					exaInfraTestResource.testAccCheckCloudExadataInfrastructureExists(ctx, resourceName, &cloudExaDataInfrastructure2),
+					testAccCheckCloudExadataInfrastructureExists(ctx, resourceName, &cloudExaDataInfrastructure2),
 					resource.ComposeTestCheckFunc(func(state *terraform.State) error {
 						if strings.Compare(*(cloudExaDataInfrastructure1.CloudExadataInfrastructureId), *(cloudExaDataInfrastructure2.CloudExadataInfrastructureId)) == 0 {
 							return errors.New("Should   create a new cloud exa basicExaInfraDataSource after update")
@@ -225,12 +225,12 @@ func TestAccODBCloudExadataInfrastructureResource_updateMaintenanceWindow(t *tes
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.ODBServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             exaInfraTestResource.testAccCheckCloudExaDataInfraDestroyed(ctx),
+		CheckDestroy:             testAccCheckCloudExadataInfrastructureDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
 				Config: exaInfraTestResource.exaDataInfraResourceBasicConfig(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					exaInfraTestResource.testAccCheckCloudExadataInfrastructureExists(ctx, resourceName, &cloudExaDataInfrastructure1),
+					testAccCheckCloudExadataInfrastructureExists(ctx, resourceName, &cloudExaDataInfrastructure1),
 				),
 			},
 			{
@@ -241,7 +241,7 @@ func TestAccODBCloudExadataInfrastructureResource_updateMaintenanceWindow(t *tes
 			{
 				Config: exaInfraTestResource.basicWithCustomMaintenanceWindow(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					exaInfraTestResource.testAccCheckCloudExadataInfrastructureExists(ctx, resourceName, &cloudExaDataInfrastructure2),
+					testAccCheckCloudExadataInfrastructureExists(ctx, resourceName, &cloudExaDataInfrastructure2),
 					resource.ComposeTestCheckFunc(func(state *terraform.State) error {
 						if strings.Compare(*(cloudExaDataInfrastructure1.CloudExadataInfrastructureId), *(cloudExaDataInfrastructure2.CloudExadataInfrastructureId)) != 0 {
 							return errors.New("Should not  create a new cloud exa basicExaInfraDataSource after  update")
@@ -277,12 +277,12 @@ func TestAccODBCloudExadataInfrastructureResource_disappears(t *testing.T) {
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.ODBServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             exaInfraTestResource.testAccCheckCloudExaDataInfraDestroyed(ctx),
+		CheckDestroy:             testAccCheckCloudExadataInfrastructureDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
 				Config: exaInfraTestResource.exaDataInfraResourceBasicConfig(rName),
 				Check: resource.ComposeTestCheckFunc(
-					exaInfraTestResource.testAccCheckCloudExadataInfrastructureExists(ctx, resourceName, &cloudExaDataInfrastructure),
+					testAccCheckCloudExadataInfrastructureExists(ctx, resourceName, &cloudExaDataInfrastructure),
 					acctest.CheckFrameworkResourceDisappears(ctx, t, tfodb.ResourceCloudExadataInfrastructure, resourceName),
 				),
 				ExpectNonEmptyPlan: true,
@@ -291,7 +291,7 @@ func TestAccODBCloudExadataInfrastructureResource_disappears(t *testing.T) {
 	})
 }
 
-func (cloudExaDataInfraResourceTest) testAccCheckCloudExaDataInfraDestroyed(ctx context.Context) resource.TestCheckFunc {
+func testAccCheckCloudExadataInfrastructureDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).ODBClient(ctx)
 
@@ -314,7 +314,7 @@ func (cloudExaDataInfraResourceTest) testAccCheckCloudExaDataInfraDestroyed(ctx
 	}
 }
 
-func (cloudExaDataInfraResourceTest) testAccCheckCloudExadataInfrastructureExists(ctx context.Context, name string, cloudExadataInfrastructure *odbtypes.CloudExadataInfrastructure) resource.TestCheckFunc {
+func testAccCheckCloudExadataInfrastructureExists(ctx context.Context, name string, cloudExadataInfrastructure *odbtypes.CloudExadataInfrastructure) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -48,6 +49,13 @@ import (
 
 // @FrameworkResource("aws_odb_cloud_vm_cluster", name="Cloud Vm Cluster")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("id")
+// @Testing(preIdentityVersion="v6.41.0")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/odb/types;odbtypes;odbtypes.CloudVmCluster")
+// @Testing(existsTakesT=false, destroyTakesT=false)
+// @Testing(preCheck="vmClusterTestEntity.testAccPreCheck")
+// @Testing(randomSSHPublicKey=true)
+// @Testing(identityRegionOverrideTest=false)
 func newResourceCloudVmCluster(_ context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourceCloudVmCluster{}
 
@@ -68,7 +76,7 @@ var ResourceCloudVmCluster = newResourceCloudVmCluster
 type resourceCloudVmCluster struct {
 	framework.ResourceWithModel[cloudVmClusterResourceModel]
 	framework.WithTimeouts
-	framework.WithImportByID
+	framework.WithImportByIdentity
 }
 
 func (r *resourceCloudVmCluster) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -609,26 +617,7 @@ func (r *resourceCloudVmCluster) Read(ctx context.Context, req resource.ReadRequ
 		)
 		return
 	}
-	hostnamePrefix := computeHostnamePrefix(out.Hostname)
-	state.HostnamePrefix = flex.StringToFramework(ctx, hostnamePrefix)
-	state.HostnamePrefixComputed = types.StringValue(*out.Hostname)
-	//scan listener port not returned by API directly
-	state.ScanListenerPortTcp = flex.Int32ToFramework(ctx, out.ListenerPort)
-	state.GiVersionComputed = flex.StringToFramework(ctx, out.GiVersion)
-	giVersionMajor, err := getMajorGiVersion(out.GiVersion)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			create.ProblemStandardMessage(names.ODB, create.ErrActionWaitingForCreation, ResNameCloudVmCluster, state.CloudVmClusterId.ValueString(), err),
-			err.Error(),
-		)
-		return
-	}
-	state.GiVersion = flex.StringToFramework(ctx, giVersionMajor)
-	state.OdbNetworkId = flex.StringToFramework(ctx, out.OdbNetworkId)
-	state.OdbNetworkArn = flex.StringToFramework(ctx, out.OdbNetworkArn)
-	state.CloudExadataInfrastructureId = flex.StringToFramework(ctx, out.CloudExadataInfrastructureId)
-	state.CloudExadataInfrastructureArn = flex.StringToFramework(ctx, out.CloudExadataInfrastructureArn)
-	resp.Diagnostics.Append(flex.Flatten(ctx, out, &state)...)
+	resp.Diagnostics.Append(flattenCloudVmClusterResource(ctx, out, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -669,6 +658,33 @@ func (r *resourceCloudVmCluster) Delete(ctx context.Context, req resource.Delete
 	}
 }
 
+func flattenCloudVmClusterResource(ctx context.Context, out *odbtypes.CloudVmCluster, data *cloudVmClusterResourceModel) diag.Diagnostics { // nosemgrep:ci.semgrep.framework.manual-flattener-functions
+	var diags diag.Diagnostics
+
+	hostnamePrefix := computeHostnamePrefix(out.Hostname)
+	data.HostnamePrefix = flex.StringToFramework(ctx, hostnamePrefix)
+	data.HostnamePrefixComputed = types.StringValue(*out.Hostname)
+	//scan listener port not returned by API directly
+	data.ScanListenerPortTcp = flex.Int32ToFramework(ctx, out.ListenerPort)
+	data.GiVersionComputed = flex.StringToFramework(ctx, out.GiVersion)
+	giVersionMajor, err := getMajorGiVersion(out.GiVersion)
+	if err != nil {
+		diags.AddError(
+			create.ProblemStandardMessage(names.ODB, create.ErrActionReading, ResNameCloudVmCluster, aws.ToString(out.CloudVmClusterId), err),
+			err.Error(),
+		)
+		return diags
+	}
+	data.GiVersion = flex.StringToFramework(ctx, giVersionMajor)
+	data.OdbNetworkId = flex.StringToFramework(ctx, out.OdbNetworkId)
+	data.OdbNetworkArn = flex.StringToFramework(ctx, out.OdbNetworkArn)
+	data.CloudExadataInfrastructureId = flex.StringToFramework(ctx, out.CloudExadataInfrastructureId)
+	data.CloudExadataInfrastructureArn = flex.StringToFramework(ctx, out.CloudExadataInfrastructureArn)
+	diags.Append(flex.Flatten(ctx, out, data)...)
+
+	return diags
+}
+
 // computes hostname prefix from hostname prefix computed value.
 func computeHostnamePrefix(hostnamePrefixComputed *string) *string {
 	suffixIndex := strings.LastIndex(*hostnamePrefixComputed, "-")
@@ -56,12 +56,12 @@ func TestAccODBCloudVmCluster_basic(t *testing.T) {
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.ODBServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             vmClusterTestEntity.testAccCheckCloudVmClusterDestroy(ctx),
+		CheckDestroy:             testAccCheckCloudVmClusterDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
 				Config: basicConfig,
 				Check: resource.ComposeAggregateTestCheckFunc(
-					vmClusterTestEntity.testAccCheckCloudVmClusterExists(ctx, resourceName, &cloudvmcluster),
+					testAccCheckCloudVmClusterExists(ctx, resourceName, &cloudvmcluster),
 				),
 			},
 			{
@@ -93,12 +93,12 @@ func TestAccODBCloudVmCluster_allParams(t *testing.T) {
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.ODBServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             vmClusterTestEntity.testAccCheckCloudVmClusterDestroy(ctx),
+		CheckDestroy:             testAccCheckCloudVmClusterDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
 				Config: vmClusterTestEntity.cloudVmClusterWithAllParameters(vmcClusterDisplayName, publicKey),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					vmClusterTestEntity.testAccCheckCloudVmClusterExists(ctx, resourceName, &cloudvmcluster),
+					testAccCheckCloudVmClusterExists(ctx, resourceName, &cloudvmcluster),
 				),
 			},
 			{
@@ -133,7 +133,7 @@ func TestAccODBCloudVmCluster_taggingTest(t *testing.T) {
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.ODBServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             vmClusterTestEntity.testAccCheckCloudVmClusterDestroy(ctx),
+		CheckDestroy:             testAccCheckCloudVmClusterDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
 				Config: vmcNoTag,
@@ -141,7 +141,7 @@ func TestAccODBCloudVmCluster_taggingTest(t *testing.T) {
 					resource.ComposeTestCheckFunc(func(state *terraform.State) error {
 						return nil
 					}),
-					vmClusterTestEntity.testAccCheckCloudVmClusterExists(ctx, resourceName, &cloudvmcluster1),
+					testAccCheckCloudVmClusterExists(ctx, resourceName, &cloudvmcluster1),
 				),
 			},
 			{
@@ -155,7 +155,7 @@ func TestAccODBCloudVmCluster_taggingTest(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "2"),
 					resource.TestCheckResourceAttr(resourceName, "tags.env", "dev"),
 					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar"),
-					vmClusterTestEntity.testAccCheckCloudVmClusterExists(ctx, resourceName, &cloudvmcluster2),
+					testAccCheckCloudVmClusterExists(ctx, resourceName, &cloudvmcluster2),
 					resource.ComposeTestCheckFunc(func(state *terraform.State) error {
 						if strings.Compare(*(cloudvmcluster1.CloudVmClusterId), *(cloudvmcluster2.CloudVmClusterId)) != 0 {
 							return errors.New("Should  not create a new cloud vm cluster for tag update")
@@ -196,7 +196,7 @@ func TestAccODBCloudVmCluster_real(t *testing.T) {
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.ODBServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             vmClusterTestEntity.testAccCheckCloudVmClusterDestroy(ctx),
+		CheckDestroy:             testAccCheckCloudVmClusterDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
 				Config: vmcWithoutTag,
@@ -204,7 +204,7 @@ func TestAccODBCloudVmCluster_real(t *testing.T) {
 					resource.ComposeTestCheckFunc(func(state *terraform.State) error {
 						return nil
 					}),
-					vmClusterTestEntity.testAccCheckCloudVmClusterExists(ctx, resourceName, &cloudvmcluster1),
+					testAccCheckCloudVmClusterExists(ctx, resourceName, &cloudvmcluster1),
 				),
 			},
 			{
@@ -217,7 +217,7 @@ func TestAccODBCloudVmCluster_real(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
 					resource.TestCheckResourceAttr(resourceName, "tags.env", "dev"),
-					vmClusterTestEntity.testAccCheckCloudVmClusterExists(ctx, resourceName, &cloudvmcluster2),
+					testAccCheckCloudVmClusterExists(ctx, resourceName, &cloudvmcluster2),
 					resource.ComposeTestCheckFunc(func(state *terraform.State) error {
 						if strings.Compare(*(cloudvmcluster1.CloudVmClusterId), *(cloudvmcluster2.CloudVmClusterId)) != 0 {
 							return errors.New("Should  not create a new cloud vm cluster for tag update")
@@ -256,12 +256,12 @@ func TestAccODBCloudVmCluster_disappears(t *testing.T) {
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.ODBServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             vmClusterTestEntity.testAccCheckCloudVmClusterDestroy(ctx),
+		CheckDestroy:             testAccCheckCloudVmClusterDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
 				Config: vmcBasicConfig,
 				Check: resource.ComposeAggregateTestCheckFunc(
-					vmClusterTestEntity.testAccCheckCloudVmClusterExists(ctx, resourceName, &cloudvmcluster),
+					testAccCheckCloudVmClusterExists(ctx, resourceName, &cloudvmcluster),
 					acctest.CheckFrameworkResourceDisappears(ctx, t, tfodb.ResourceCloudVmCluster, resourceName),
 				),
 				ExpectNonEmptyPlan: true,
@@ -293,7 +293,7 @@ func TestAccODBCloudVmCluster_usingARN(t *testing.T) {
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.ODBServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             vmClusterTestEntity.testAccCheckCloudVmClusterDestroy(ctx),
+		CheckDestroy:             testAccCheckCloudVmClusterDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
 				Config: vmcWithoutTag,
@@ -301,7 +301,7 @@ func TestAccODBCloudVmCluster_usingARN(t *testing.T) {
 					resource.ComposeTestCheckFunc(func(state *terraform.State) error {
 						return nil
 					}),
-					vmClusterTestEntity.testAccCheckCloudVmClusterExists(ctx, resourceName, &cloudvmcluster1),
+					testAccCheckCloudVmClusterExists(ctx, resourceName, &cloudvmcluster1),
 				),
 			},
 			{
@@ -314,7 +314,7 @@ func TestAccODBCloudVmCluster_usingARN(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
 					resource.TestCheckResourceAttr(resourceName, "tags.env", "dev"),
-					vmClusterTestEntity.testAccCheckCloudVmClusterExists(ctx, resourceName, &cloudvmcluster2),
+					testAccCheckCloudVmClusterExists(ctx, resourceName, &cloudvmcluster2),
 					resource.ComposeTestCheckFunc(func(state *terraform.State) error {
 						if strings.Compare(*(cloudvmcluster1.CloudVmClusterId), *(cloudvmcluster2.CloudVmClusterId)) != 0 {
 							return errors.New("Should  not create a new cloud vm cluster for tag update")
@@ -346,7 +346,7 @@ func TestAccODBCloudVmCluster_variables(t *testing.T) {
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.ODBServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             vmClusterTestEntity.testAccCheckCloudVmClusterDestroy(ctx),
+		CheckDestroy:             testAccCheckCloudVmClusterDestroy(ctx),
 		Steps: []resource.TestStep{
 			// nosemgrep:ci.semgrep.acctest.checks.replace-planonly-checks
 			{
@@ -358,7 +358,7 @@ func TestAccODBCloudVmCluster_variables(t *testing.T) {
 	})
 }
 
-func (cloudVmClusterResourceTest) testAccCheckCloudVmClusterDestroy(ctx context.Context) resource.TestCheckFunc {
+func testAccCheckCloudVmClusterDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).ODBClient(ctx)
 		for _, rs := range s.RootModule().Resources {
@@ -378,7 +378,7 @@ func (cloudVmClusterResourceTest) testAccCheckCloudVmClusterDestroy(ctx context.
 	}
 }
 
-func (cloudVmClusterResourceTest) testAccCheckCloudVmClusterExists(ctx context.Context, name string, cloudvmcluster *odbtypes.CloudVmCluster) resource.TestCheckFunc {
+func testAccCheckCloudVmClusterExists(ctx context.Context, name string, cloudvmcluster *odbtypes.CloudVmCluster) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
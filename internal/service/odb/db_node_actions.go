@@ -0,0 +1,157 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package odb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/odb"
+	odbtypes "github.com/aws/aws-sdk-go-v2/service/odb/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// dbNodeActionPollInterval defines polling cadence for the DB node actions.
+var dbNodeActionPollInterval = 30 * time.Second
+
+type dbNodeActionModel struct {
+	framework.WithRegionModel
+	CloudVmClusterId types.String `tfsdk:"cloud_vm_cluster_id"`
+	DbNodeId         types.String `tfsdk:"db_node_id"`
+	Timeout          types.Int64  `tfsdk:"timeout"`
+}
+
+func dbNodeActionSchema(description, verb string) schema.Schema {
+	return schema.Schema{
+		Description: description,
+		Attributes: map[string]schema.Attribute{
+			"cloud_vm_cluster_id": schema.StringAttribute{
+				Description: "The unique identifier of the VM cluster that contains the DB node",
+				Required:    true,
+			},
+			"db_node_id": schema.StringAttribute{
+				Description: fmt.Sprintf("The unique identifier of the DB node to %s", verb),
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: fmt.Sprintf("Timeout in seconds to wait for the DB node to %s (default: 1800)", verb),
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(7200),
+				},
+			},
+		},
+	}
+}
+
+// waitDBNodeActionStatus polls the DB node until it reaches the target status,
+// sending a progress update every polling interval.
+func waitDBNodeActionStatus(ctx context.Context, conn *odb.Client, cloudVMClusterID, dbNodeID string, timeout time.Duration, cb fwactions.SendProgressFunc, target odbtypes.DbNodeResourceStatus, transitional ...odbtypes.DbNodeResourceStatus) error {
+	return waitDBNodeStatus(ctx, conn, cloudVMClusterID, dbNodeID, timeout, cb, []odbtypes.DbNodeResourceStatus{target}, transitional)
+}
+
+// waitDBNodeRebooted waits for a rebooted DB node to leave the AVAILABLE status and then return to it.
+// RebootDbNode can return while the DB node is still AVAILABLE, so waiting for AVAILABLE alone
+// would succeed before the reboot has started.
+func waitDBNodeRebooted(ctx context.Context, conn *odb.Client, cloudVMClusterID, dbNodeID string, initial odbtypes.DbNodeResourceStatus, timeout time.Duration, cb fwactions.SendProgressFunc) error {
+	rebootingStates := []odbtypes.DbNodeResourceStatus{
+		odbtypes.DbNodeResourceStatusUpdating,
+		odbtypes.DbNodeResourceStatusStopping,
+		odbtypes.DbNodeResourceStatusStopped,
+		odbtypes.DbNodeResourceStatusStarting,
+	}
+	deadline := time.Now().Add(timeout)
+
+	if !slices.Contains(rebootingStates, initial) {
+		err := waitDBNodeStatus(ctx, conn, cloudVMClusterID, dbNodeID, timeout, cb, rebootingStates, []odbtypes.DbNodeResourceStatus{odbtypes.DbNodeResourceStatusAvailable})
+		if err != nil {
+			return err
+		}
+	}
+
+	remaining := time.Until(deadline)
+	if remaining <= 0 {
+		return &actionwait.TimeoutError{Timeout: timeout}
+	}
+
+	return waitDBNodeStatus(ctx, conn, cloudVMClusterID, dbNodeID, remaining, cb, []odbtypes.DbNodeResourceStatus{odbtypes.DbNodeResourceStatusAvailable}, rebootingStates)
+}
+
+func waitDBNodeStatus(ctx context.Context, conn *odb.Client, cloudVMClusterID, dbNodeID string, timeout time.Duration, cb fwactions.SendProgressFunc, targets, transitional []odbtypes.DbNodeResourceStatus) error {
+	successStates := make([]actionwait.Status, 0, len(targets))
+	for _, v := range targets {
+		successStates = append(successStates, actionwait.Status(v))
+	}
+	transitionalStates := make([]actionwait.Status, 0, len(transitional))
+	for _, v := range transitional {
+		transitionalStates = append(transitionalStates, actionwait.Status(v))
+	}
+
+	_, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[struct{}], error) {
+		dbNode, err := findDBNodeByTwoPartKey(ctx, conn, cloudVMClusterID, dbNodeID)
+		if err != nil {
+			return actionwait.FetchResult[struct{}]{}, fmt.Errorf("describing DB node: %w", err)
+		}
+		return actionwait.FetchResult[struct{}]{Status: actionwait.Status(dbNode.Status)}, nil
+	}, actionwait.Options[struct{}]{
+		Timeout:            timeout,
+		Interval:           actionwait.FixedInterval(dbNodeActionPollInterval),
+		ProgressInterval:   dbNodeActionPollInterval,
+		SuccessStates:      successStates,
+		TransitionalStates: transitionalStates,
+		FailureStates: []actionwait.Status{
+			actionwait.Status(odbtypes.DbNodeResourceStatusFailed),
+			actionwait.Status(odbtypes.DbNodeResourceStatusTerminating),
+			actionwait.Status(odbtypes.DbNodeResourceStatusTerminated),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			cb(ctx, "DB node %s is currently in status '%s', continuing to wait for '%s'...", dbNodeID, fr.Status, strings.Join(tfslices.ApplyToAll(targets, func(v odbtypes.DbNodeResourceStatus) string { return string(v) }), "' or '"))
+		},
+	})
+
+	return err
+}
+
+// addDBNodeActionWaitError converts an actionwait error into a diagnostic.
+func addDBNodeActionWaitError(diags *diag.Diagnostics, err error, dbNodeID, operation string, timeout time.Duration) {
+	verb := strings.ToLower(operation)
+	var timeoutErr *actionwait.TimeoutError
+	var failureErr *actionwait.FailureStateError
+	var unexpectedErr *actionwait.UnexpectedStateError
+	if errors.As(err, &timeoutErr) {
+		diags.AddError(
+			"Timeout Waiting for DB Node to "+operation,
+			fmt.Sprintf("DB node %s did not %s within %s: %s", dbNodeID, verb, timeout, err),
+		)
+	} else if errors.As(err, &failureErr) {
+		diags.AddError(
+			"DB Node Failed to "+operation,
+			fmt.Sprintf("DB node %s entered a failure status: %s", dbNodeID, err),
+		)
+	} else if errors.As(err, &unexpectedErr) {
+		diags.AddError(
+			"Unexpected DB Node Status",
+			fmt.Sprintf("DB node %s entered unexpected status: %s", dbNodeID, err),
+		)
+	} else {
+		diags.AddError(
+			"Error Waiting for DB Node to "+operation,
+			fmt.Sprintf("Error while waiting for DB node %s to %s: %s", dbNodeID, verb, err),
+		)
+	}
+}
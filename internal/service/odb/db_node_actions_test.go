@@ -0,0 +1,115 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package odb
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	odbtypes "github.com/aws/aws-sdk-go-v2/service/odb/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+)
+
+// dbNodeStatusSequenceHandler returns an HTTP handler that answers GetDbNode with the given
+// statuses in order, repeating the last one once the sequence is exhausted.
+func dbNodeStatusSequenceHandler(calls *atomic.Int32, statuses ...odbtypes.DbNodeResourceStatus) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		i := int(calls.Add(1)) - 1
+		i = min(i, len(statuses)-1)
+
+		jsonHandler(http.StatusOK, map[string]any{
+			"dbNode": map[string]any{
+				"dbNodeId": "dbnode-test",
+				"status":   string(statuses[i]),
+			},
+		})(w, r)
+	}
+}
+
+func noopSendProgress(context.Context, string, ...any) {}
+
+func setDBNodeActionPollInterval(t *testing.T, interval time.Duration) {
+	t.Helper()
+
+	old := dbNodeActionPollInterval
+	dbNodeActionPollInterval = interval
+	t.Cleanup(func() {
+		dbNodeActionPollInterval = old
+	})
+}
+
+func TestWaitDBNodeRebooted(t *testing.T) { //nolint:tparallel // overrides the package-level poll interval
+	setDBNodeActionPollInterval(t, time.Millisecond)
+
+	testCases := map[string]struct {
+		initial       odbtypes.DbNodeResourceStatus
+		statuses      []odbtypes.DbNodeResourceStatus
+		expectTimeout bool
+		minCalls      int32
+	}{
+		"no transition": {
+			initial: odbtypes.DbNodeResourceStatusAvailable,
+			statuses: []odbtypes.DbNodeResourceStatus{
+				odbtypes.DbNodeResourceStatusAvailable,
+			},
+			expectTimeout: true,
+		},
+		"transition through updating": {
+			initial: odbtypes.DbNodeResourceStatusAvailable,
+			statuses: []odbtypes.DbNodeResourceStatus{
+				odbtypes.DbNodeResourceStatusAvailable,
+				odbtypes.DbNodeResourceStatusUpdating,
+				odbtypes.DbNodeResourceStatusAvailable,
+			},
+			minCalls: 3,
+		},
+		"transition through stopping and starting": {
+			statuses: []odbtypes.DbNodeResourceStatus{
+				odbtypes.DbNodeResourceStatusStopping,
+				odbtypes.DbNodeResourceStatusStopped,
+				odbtypes.DbNodeResourceStatusStarting,
+				odbtypes.DbNodeResourceStatusAvailable,
+			},
+			minCalls: 4,
+		},
+		"seeded from reboot output": {
+			initial: odbtypes.DbNodeResourceStatusUpdating,
+			statuses: []odbtypes.DbNodeResourceStatus{
+				odbtypes.DbNodeResourceStatusAvailable,
+			},
+			minCalls: 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			var calls atomic.Int32
+			conn := newTestClient(t, dbNodeStatusSequenceHandler(&calls, testCase.statuses...))
+
+			err := waitDBNodeRebooted(ctx, conn, "vmc-test", "dbnode-test", testCase.initial, 250*time.Millisecond, noopSendProgress)
+
+			if testCase.expectTimeout {
+				if timeoutErr, ok := errors.AsType[*actionwait.TimeoutError](err); !ok {
+					t.Fatalf("expected timeout error, got: %v", err)
+				} else if got, want := timeoutErr.LastStatus, actionwait.Status(odbtypes.DbNodeResourceStatusAvailable); got != want {
+					t.Errorf("last status = %q, want %q", got, want)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := calls.Load(); got < testCase.minCalls {
+				t.Errorf("GetDbNode calls = %d, want at least %d", got, testCase.minCalls)
+			}
+		})
+	}
+}
@@ -8,16 +8,20 @@ package odb
 import (
 	"context"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/odb"
 	odbtypes "github.com/aws/aws-sdk-go-v2/service/odb/types"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -162,11 +166,7 @@ func (d *dataSourceDbNode) Read(ctx context.Context, req datasource.ReadRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	input := odb.GetDbNodeInput{
-		DbNodeId:         data.DbNodeId.ValueStringPointer(),
-		CloudVmClusterId: data.CloudVmClusterId.ValueStringPointer(),
-	}
-	out, err := conn.GetDbNode(ctx, &input)
+	out, err := findDBNodeByTwoPartKey(ctx, conn, data.CloudVmClusterId.ValueString(), data.DbNodeId.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			create.ProblemStandardMessage(names.ODB, create.ErrActionReading, DSNameDBNode, data.DbNodeId.ValueString(), err),
@@ -174,13 +174,38 @@ func (d *dataSourceDbNode) Read(ctx context.Context, req datasource.ReadRequest,
 		)
 		return
 	}
-	resp.Diagnostics.Append(flex.Flatten(ctx, out.DbNode, &data)...)
+	resp.Diagnostics.Append(flex.Flatten(ctx, out, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+func findDBNodeByTwoPartKey(ctx context.Context, conn *odb.Client, cloudVMClusterID, dbNodeID string) (*odbtypes.DbNode, error) {
+	input := odb.GetDbNodeInput{
+		CloudVmClusterId: aws.String(cloudVMClusterID),
+		DbNodeId:         aws.String(dbNodeID),
+	}
+
+	out, err := conn.GetDbNode(ctx, &input)
+	if err != nil {
+		if errs.IsA[*odbtypes.ResourceNotFoundException](err) {
+			return nil, &sdkretry.NotFoundError{
+				LastError:   err,
+				LastRequest: &input,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil || out.DbNode == nil {
+		return nil, tfresource.NewEmptyResultError()
+	}
+
+	return out.DbNode, nil
+}
+
 type dbNodeDataSourceModel struct {
 	framework.WithRegionModel
 	CloudVmClusterId           types.String                                       `tfsdk:"cloud_vm_cluster_id"`
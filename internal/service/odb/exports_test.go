@@ -11,4 +11,6 @@ var (
 	FindCloudAutonomousVmClusterByID  = findCloudAutonomousVmClusterByID
 	FindExadataInfraResourceByID      = findExadataInfraResourceByID
 	FindCloudVmClusterForResourceByID = findCloudVmClusterForResourceByID
+	FindDBNodeByTwoPartKey            = findDBNodeByTwoPartKey
+	FindServiceOnboarding             = findServiceOnboarding
 )
@@ -46,6 +46,13 @@ import (
 
 // @FrameworkResource("aws_odb_network", name="Network")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("id")
+// @Testing(preIdentityVersion="v6.41.0")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/odb/types;odbtypes;odbtypes.OdbNetwork")
+// @Testing(existsTakesT=false, destroyTakesT=false)
+// @Testing(preCheck="oracleDBNetworkResourceTestEntity.testAccPreCheck")
+// @Testing(importIgnore="delete_associated_resources")
+// @Testing(identityRegionOverrideTest=false)
 func newResourceNetwork(_ context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourceNetwork{}
 	r.SetDefaultCreateTimeout(24 * time.Hour)
@@ -62,7 +69,7 @@ const (
 type resourceNetwork struct {
 	framework.ResourceWithModel[odbNetworkResourceModel]
 	framework.WithTimeouts
-	framework.WithImportByID
+	framework.WithImportByIdentity
 }
 
 var OracleDBNetwork = newResourceNetwork
@@ -458,67 +465,7 @@ func (r *resourceNetwork) Read(ctx context.Context, req resource.ReadRequest, re
 		)
 		return
 	}
-	if out.ManagedServices == nil {
-		resp.Diagnostics.AddError(
-			create.ProblemStandardMessage(names.ODB, create.ErrActionReading, ResNameNetwork, state.OdbNetworkId.String(), errors.New("odbNetwork managed service not found")),
-			"Odb Network managed service cannot be nil",
-		)
-		return
-	} else {
-		readS3AccessStatus, err := mapManagedServiceStatusToAccessStatus(out.ManagedServices.S3Access.Status)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				create.ProblemStandardMessage(names.ODB, create.ErrActionReading, ResNameNetwork, state.OdbNetworkId.String(), err),
-				err.Error(),
-			)
-			return
-		}
-		state.S3Access = fwtypes.StringEnumValue(readS3AccessStatus)
-		state.S3PolicyDocument = types.StringPointerValue(out.ManagedServices.S3Access.S3PolicyDocument)
-
-		readZeroEtlAccessStatus, err := mapManagedServiceStatusToAccessStatus(out.ManagedServices.ZeroEtlAccess.Status)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				create.ProblemStandardMessage(names.ODB, create.ErrActionReading, ResNameNetwork, state.OdbNetworkId.String(), err),
-				err.Error(),
-			)
-			return
-		}
-		state.ZeroEtlAccess = fwtypes.StringEnumValue(readZeroEtlAccessStatus)
-
-		readStsAccessStatus, err := mapManagedServiceStatusToAccessStatus(out.ManagedServices.StsAccess.Status)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				create.ProblemStandardMessage(names.ODB, create.ErrActionReading, ResNameNetwork, state.OdbNetworkId.String(), err),
-				err.Error(),
-			)
-			return
-		}
-		state.StsAccess = fwtypes.StringEnumValue(readStsAccessStatus)
-		state.StsPolicyDocument = types.StringPointerValue(out.ManagedServices.StsAccess.StsPolicyDocument)
-
-		readKmsAccessStatus, err := mapManagedServiceStatusToAccessStatus(out.ManagedServices.KmsAccess.Status)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				create.ProblemStandardMessage(names.ODB, create.ErrActionReading, ResNameNetwork, state.OdbNetworkId.String(), err),
-				err.Error(),
-			)
-			return
-		}
-		state.KmsAccess = fwtypes.StringEnumValue(readKmsAccessStatus)
-		state.KmsPolicyDocument = types.StringPointerValue(out.ManagedServices.KmsAccess.KmsPolicyDocument)
-
-		if out.ManagedServices.CrossRegionS3RestoreSourcesAccess != nil {
-			elements := enabledCrossRegionRestoreElements(out.ManagedServices.CrossRegionS3RestoreSourcesAccess)
-			setVal, diagnostics := fwtypes.NewSetValueOf[types.String](ctx, elements)
-			resp.Diagnostics.Append(diagnostics...)
-			if resp.Diagnostics.HasError() {
-				return
-			}
-			state.CrossRegionS3RestoreSourcesAccess = setVal
-		}
-	}
-	resp.Diagnostics.Append(flex.Flatten(ctx, out, &state)...)
+	resp.Diagnostics.Append(flattenNetworkResource(ctx, out, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -742,6 +689,75 @@ func (r *resourceNetwork) Delete(ctx context.Context, req resource.DeleteRequest
 	}
 }
 
+func flattenNetworkResource(ctx context.Context, out *odbtypes.OdbNetwork, data *odbNetworkResourceModel) diag.Diagnostics { // nosemgrep:ci.semgrep.framework.manual-flattener-functions
+	var diags diag.Diagnostics
+
+	if out.ManagedServices == nil {
+		diags.AddError(
+			create.ProblemStandardMessage(names.ODB, create.ErrActionReading, ResNameNetwork, aws.ToString(out.OdbNetworkId), errors.New("odbNetwork managed service not found")),
+			"Odb Network managed service cannot be nil",
+		)
+		return diags
+	}
+
+	readS3AccessStatus, err := mapManagedServiceStatusToAccessStatus(out.ManagedServices.S3Access.Status)
+	if err != nil {
+		diags.AddError(
+			create.ProblemStandardMessage(names.ODB, create.ErrActionReading, ResNameNetwork, aws.ToString(out.OdbNetworkId), err),
+			err.Error(),
+		)
+		return diags
+	}
+	data.S3Access = fwtypes.StringEnumValue(readS3AccessStatus)
+	data.S3PolicyDocument = types.StringPointerValue(out.ManagedServices.S3Access.S3PolicyDocument)
+
+	readZeroEtlAccessStatus, err := mapManagedServiceStatusToAccessStatus(out.ManagedServices.ZeroEtlAccess.Status)
+	if err != nil {
+		diags.AddError(
+			create.ProblemStandardMessage(names.ODB, create.ErrActionReading, ResNameNetwork, aws.ToString(out.OdbNetworkId), err),
+			err.Error(),
+		)
+		return diags
+	}
+	data.ZeroEtlAccess = fwtypes.StringEnumValue(readZeroEtlAccessStatus)
+
+	readStsAccessStatus, err := mapManagedServiceStatusToAccessStatus(out.ManagedServices.StsAccess.Status)
+	if err != nil {
+		diags.AddError(
+			create.ProblemStandardMessage(names.ODB, create.ErrActionReading, ResNameNetwork, aws.ToString(out.OdbNetworkId), err),
+			err.Error(),
+		)
+		return diags
+	}
+	data.StsAccess = fwtypes.StringEnumValue(readStsAccessStatus)
+	data.StsPolicyDocument = types.StringPointerValue(out.ManagedServices.StsAccess.StsPolicyDocument)
+
+	readKmsAccessStatus, err := mapManagedServiceStatusToAccessStatus(out.ManagedServices.KmsAccess.Status)
+	if err != nil {
+		diags.AddError(
+			create.ProblemStandardMessage(names.ODB, create.ErrActionReading, ResNameNetwork, aws.ToString(out.OdbNetworkId), err),
+			err.Error(),
+		)
+		return diags
+	}
+	data.KmsAccess = fwtypes.StringEnumValue(readKmsAccessStatus)
+	data.KmsPolicyDocument = types.StringPointerValue(out.ManagedServices.KmsAccess.KmsPolicyDocument)
+
+	if out.ManagedServices.CrossRegionS3RestoreSourcesAccess != nil {
+		elements := enabledCrossRegionRestoreElements(out.ManagedServices.CrossRegionS3RestoreSourcesAccess)
+		setVal, diagnostics := fwtypes.NewSetValueOf[types.String](ctx, elements)
+		diags.Append(diagnostics...)
+		if diags.HasError() {
+			return diags
+		}
+		data.CrossRegionS3RestoreSourcesAccess = setVal
+	}
+
+	diags.Append(flex.Flatten(ctx, out, data)...)
+
+	return diags
+}
+
 func waitNetworkCreated(ctx context.Context, conn *odb.Client, id string, timeout time.Duration) (*odbtypes.OdbNetwork, error) {
 	stateConf := &sdkretry.StateChangeConf{
 		Pending: enum.Slice(odbtypes.ResourceStatusProvisioning),
@@ -0,0 +1,89 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package odb
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkListResource("aws_odb_network")
+func newNetworkResourceAsListResource() list.ListResourceWithConfigure {
+	return &listResourceNetwork{}
+}
+
+var _ list.ListResource = &listResourceNetwork{}
+
+type listResourceNetwork struct {
+	resourceNetwork
+	framework.WithList
+}
+
+func (r *listResourceNetwork) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	conn := r.Meta().ODBClient(ctx)
+
+	var query networkListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		result := request.NewListResult(ctx)
+		output, err := ListOracleDBNetworks(ctx, conn)
+		if err != nil {
+			result = fwdiag.NewListResultErrorDiagnostic(err)
+			yield(result)
+			return
+		}
+
+		for _, summary := range output.OdbNetworks {
+			id := aws.ToString(summary.OdbNetworkId)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), id)
+
+			network, err := FindOracleDBNetworkResourceByID(ctx, conn, id)
+			if retry.NotFound(err) {
+				tflog.Warn(ctx, "ODB Network not found during listing")
+				continue
+			}
+			if err != nil {
+				tflog.Error(ctx, "Reading ODB Network", map[string]any{"error": err.Error()})
+				continue
+			}
+
+			var data odbNetworkResourceModel
+			r.SetResult(ctx, r.Meta(), request.IncludeResource, &data, &result, func() {
+				result.Diagnostics.Append(flattenNetworkResource(ctx, network, &data)...)
+				if result.Diagnostics.HasError() {
+					return
+				}
+
+				result.DisplayName = aws.ToString(network.DisplayName)
+			})
+
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+type networkListResourceModel struct {
+	framework.WithRegionModel
+}
@@ -110,7 +110,7 @@ func TestAccODBNetworkPeeringConnection_basic(t *testing.T) {
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.ODBServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             oracleDBNwkPeeringTestResource.testAccCheckNetworkPeeringConnectionDestroy(ctx),
+		CheckDestroy:             testAccCheckNetworkPeeringConnectionDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
 				Config: oracleDBNwkPeeringTestResource.basicConfig(vpcName, odbNetName, odbPeeringDisplayName),
@@ -149,7 +149,7 @@ func TestAccODBNetworkPeeringConnection_withARN(t *testing.T) {
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.ODBServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             oracleDBNwkPeeringTestResource.testAccCheckNetworkPeeringConnectionDestroy(ctx),
+		CheckDestroy:             testAccCheckNetworkPeeringConnectionDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
 				Config: oracleDBNwkPeeringTestResource.basicConfigWithARN(vpcName, odbNetName, odbPeeringDisplayName),
@@ -185,7 +185,7 @@ func TestAccODBNetworkPeeringConnection_variables(t *testing.T) {
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.ODBServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             oracleDBNwkPeeringTestResource.testAccCheckNetworkPeeringConnectionDestroy(ctx),
+		CheckDestroy:             testAccCheckNetworkPeeringConnectionDestroy(ctx),
 		Steps: []resource.TestStep{
 			// nosemgrep:ci.semgrep.acctest.checks.replace-planonly-checks
 			{
@@ -217,7 +217,7 @@ func TestAccODBNetworkPeeringConnection_tagging(t *testing.T) {
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.ODBServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             oracleDBNwkPeeringTestResource.testAccCheckNetworkPeeringConnectionDestroy(ctx),
+		CheckDestroy:             testAccCheckNetworkPeeringConnectionDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
 				Config: oracleDBNwkPeeringTestResource.basicConfig(vpcName, odbNetName, odbPeeringDisplayName),
@@ -269,7 +269,7 @@ func TestAccODBNetworkPeeringConnection_addRemovePeeredCIDR(t *testing.T) {
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.ODBServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             oracleDBNwkPeeringTestResource.testAccCheckNetworkPeeringConnectionDestroy(ctx),
+		CheckDestroy:             testAccCheckNetworkPeeringConnectionDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
 				Config: basicConfig,
@@ -339,7 +339,7 @@ func TestAccODBNetworkPeeringConnection_updateTagAndRemovePeeredCIDR(t *testing.
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.ODBServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             oracleDBNwkPeeringTestResource.testAccCheckNetworkPeeringConnectionDestroy(ctx),
+		CheckDestroy:             testAccCheckNetworkPeeringConnectionDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
 				Config: basicConfig,
@@ -392,7 +392,7 @@ func TestAccODBNetworkPeeringConnection_disappears(t *testing.T) {
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.ODBServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             oracleDBNwkPeeringTestResource.testAccCheckNetworkPeeringConnectionDestroy(ctx),
+		CheckDestroy:             testAccCheckNetworkPeeringConnectionDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
 				Config: oracleDBNwkPeeringTestResource.basicConfig(vpcName, odbNetDisplayName, odbPeeringDisplayName),
@@ -406,7 +406,7 @@ func TestAccODBNetworkPeeringConnection_disappears(t *testing.T) {
 	})
 }
 
-func (oracleDBNwkPeeringResourceTest) testAccCheckNetworkPeeringConnectionDestroy(ctx context.Context) resource.TestCheckFunc {
+func testAccCheckNetworkPeeringConnectionDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).ODBClient(ctx)
 		for _, rs := range s.RootModule().Resources {
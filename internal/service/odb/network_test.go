@@ -51,12 +51,12 @@ func TestAccODBNetworkResource_basic(t *testing.T) {
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.ODBServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             oracleDBNetworkResourceTestEntity.testAccCheckNetworkDestroy(ctx),
+		CheckDestroy:             testAccCheckNetworkDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
 				Config: oracleDBNetworkResourceTestEntity.basicNetwork(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					oracleDBNetworkResourceTestEntity.testAccCheckNetworkExists(ctx, resourceName, &network),
+					testAccCheckNetworkExists(ctx, resourceName, &network),
 				),
 			},
 			{
@@ -88,12 +88,12 @@ func TestAccODBNetworkResource_withAllParams(t *testing.T) {
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.ODBServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             oracleDBNetworkResourceTestEntity.testAccCheckNetworkDestroy(ctx),
+		CheckDestroy:             testAccCheckNetworkDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
 				Config: oracleDBNetworkResourceTestEntity.networkWithAllParams(rName, "julia.com", endpoints.UsWest2RegionID),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					oracleDBNetworkResourceTestEntity.testAccCheckNetworkExists(ctx, resourceName, &network1),
+					testAccCheckNetworkExists(ctx, resourceName, &network1),
 					resource.TestCheckResourceAttr(
 						resourceName,
 						"cross_region_s3_restore_sources_access.#",
@@ -135,12 +135,12 @@ func TestAccODBNetworkResource_updateManagedService(t *testing.T) {
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.ODBServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             oracleDBNetworkResourceTestEntity.testAccCheckNetworkDestroy(ctx),
+		CheckDestroy:             testAccCheckNetworkDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
 				Config: oracleDBNetworkResourceTestEntity.basicNetwork(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					oracleDBNetworkResourceTestEntity.testAccCheckNetworkExists(ctx, resourceName, &network1),
+					testAccCheckNetworkExists(ctx, resourceName, &network1),
 				),
 			},
 			{
@@ -152,7 +152,7 @@ func TestAccODBNetworkResource_updateManagedService(t *testing.T) {
 			{
 				Config: oracleDBNetworkResourceTestEntity.basicNetworkWithActiveManagedService(rName, endpoints.UsWest2RegionID),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					oracleDBNetworkResourceTestEntity.testAccCheckNetworkExists(ctx, resourceName, &network2),
+					testAccCheckNetworkExists(ctx, resourceName, &network2),
 					resource.ComposeTestCheckFunc(func(state *terraform.State) error {
 						if strings.Compare(*(network1.OdbNetworkId), *(network2.OdbNetworkId)) != 0 {
 							return errors.New("should not  create a new cloud odb network")
@@ -190,12 +190,12 @@ func TestAccODBNetworkResource_disableManagedService(t *testing.T) {
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.ODBServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             oracleDBNetworkResourceTestEntity.testAccCheckNetworkDestroy(ctx),
+		CheckDestroy:             testAccCheckNetworkDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
 				Config: oracleDBNetworkResourceTestEntity.basicNetworkWithActiveManagedService(rName, endpoints.UsWest2RegionID),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					oracleDBNetworkResourceTestEntity.testAccCheckNetworkExists(ctx, resourceName, &network1),
+					testAccCheckNetworkExists(ctx, resourceName, &network1),
 				),
 			},
 			{
@@ -207,7 +207,7 @@ func TestAccODBNetworkResource_disableManagedService(t *testing.T) {
 			{
 				Config: oracleDBNetworkResourceTestEntity.basicNetwork(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					oracleDBNetworkResourceTestEntity.testAccCheckNetworkExists(ctx, resourceName, &network2),
+					testAccCheckNetworkExists(ctx, resourceName, &network2),
 					resource.ComposeTestCheckFunc(func(state *terraform.State) error {
 						if strings.Compare(*(network1.OdbNetworkId), *(network2.OdbNetworkId)) != 0 {
 							return errors.New("should not  create a new cloud odb network")
@@ -250,19 +250,19 @@ func TestAccODBNetworkResource_updateTags(t *testing.T) {
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.ODBServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             oracleDBNetworkResourceTestEntity.testAccCheckNetworkDestroy(ctx),
+		CheckDestroy:             testAccCheckNetworkDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
 				Config: oracleDBNetworkResourceTestEntity.basicNetwork(rName),
 
 				Check: resource.ComposeAggregateTestCheckFunc(
-					oracleDBNetworkResourceTestEntity.testAccCheckNetworkExists(ctx, resourceName, &network1),
+					testAccCheckNetworkExists(ctx, resourceName, &network1),
 				),
 			},
 			{
 				Config: oracleDBNetworkResourceTestEntity.updateNetworkTags(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					oracleDBNetworkResourceTestEntity.testAccCheckNetworkExists(ctx, resourceName, &network2),
+					testAccCheckNetworkExists(ctx, resourceName, &network2),
 					resource.ComposeTestCheckFunc(func(state *terraform.State) error {
 						if strings.Compare(*(network1.OdbNetworkId), *(network2.OdbNetworkId)) != 0 {
 							return errors.New("should not  create a new cloud odb network")
@@ -300,12 +300,12 @@ func TestAccODBNetworkResource_disappears(t *testing.T) {
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.ODBServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             oracleDBNetworkResourceTestEntity.testAccCheckNetworkDestroy(ctx),
+		CheckDestroy:             testAccCheckNetworkDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
 				Config: oracleDBNetworkResourceTestEntity.basicNetwork(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					oracleDBNetworkResourceTestEntity.testAccCheckNetworkExists(ctx, resourceName, &network),
+					testAccCheckNetworkExists(ctx, resourceName, &network),
 					acctest.CheckFrameworkResourceDisappears(ctx, t, tfodb.OracleDBNetwork, resourceName),
 				),
 				ExpectNonEmptyPlan: true,
@@ -333,12 +333,12 @@ func TestAccODBNetworkResource_updateDeleteAssociatedResource(t *testing.T) {
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.ODBServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             oracleDBNetworkResourceTestEntity.testAccCheckNetworkDestroy(ctx),
+		CheckDestroy:             testAccCheckNetworkDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
 				Config: oracleDBNetworkResourceTestEntity.basicNetworkWithWithDeleteAssociatedResourceFalse(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					oracleDBNetworkResourceTestEntity.testAccCheckNetworkExists(ctx, resourceName, &network1),
+					testAccCheckNetworkExists(ctx, resourceName, &network1),
 				),
 			},
 			{
@@ -350,7 +350,7 @@ func TestAccODBNetworkResource_updateDeleteAssociatedResource(t *testing.T) {
 			{
 				Config: oracleDBNetworkResourceTestEntity.basicNetwork(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					oracleDBNetworkResourceTestEntity.testAccCheckNetworkExists(ctx, resourceName, &network2),
+					testAccCheckNetworkExists(ctx, resourceName, &network2),
 					resource.ComposeTestCheckFunc(func(state *terraform.State) error {
 						if strings.Compare(*(network1.OdbNetworkId), *(network2.OdbNetworkId)) != 0 {
 							return errors.New("should not  create a new cloud odb network")
@@ -388,12 +388,12 @@ func TestAccODBNetworkResource_updateCrossRegionRestore(t *testing.T) {
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.ODBServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             oracleDBNetworkResourceTestEntity.testAccCheckNetworkDestroy(ctx),
+		CheckDestroy:             testAccCheckNetworkDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
 				Config: oracleDBNetworkResourceTestEntity.basicNetworkWithActiveManagedService(rName, endpoints.UsWest2RegionID),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					oracleDBNetworkResourceTestEntity.testAccCheckNetworkExists(ctx, resourceName, &network1),
+					testAccCheckNetworkExists(ctx, resourceName, &network1),
 					resource.TestCheckResourceAttr(
 						resourceName,
 						"cross_region_s3_restore_sources_access.#",
@@ -415,7 +415,7 @@ func TestAccODBNetworkResource_updateCrossRegionRestore(t *testing.T) {
 			{
 				Config: oracleDBNetworkResourceTestEntity.updateNetworkCrossRegionRestore(rName, endpoints.UsEast2RegionID),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					oracleDBNetworkResourceTestEntity.testAccCheckNetworkExists(ctx, resourceName, &network2),
+					testAccCheckNetworkExists(ctx, resourceName, &network2),
 					resource.ComposeTestCheckFunc(func(state *terraform.State) error {
 						if *(network1.OdbNetworkId) != *(network2.OdbNetworkId) {
 							return errors.New("should not create a new cloud odb network")
@@ -444,7 +444,7 @@ func TestAccODBNetworkResource_updateCrossRegionRestore(t *testing.T) {
 	})
 }
 
-func (oracleDBNetworkResourceTest) testAccCheckNetworkDestroy(ctx context.Context) resource.TestCheckFunc {
+func testAccCheckNetworkDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).ODBClient(ctx)
 
@@ -467,7 +467,7 @@ func (oracleDBNetworkResourceTest) testAccCheckNetworkDestroy(ctx context.Contex
 	}
 }
 
-func (oracleDBNetworkResourceTest) testAccCheckNetworkExists(ctx context.Context, name string, network *odbtypes.OdbNetwork) resource.TestCheckFunc {
+func testAccCheckNetworkExists(ctx context.Context, name string, network *odbtypes.OdbNetwork) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
@@ -0,0 +1,85 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package odb
+
+import (
+	"context"
+
+	odbtypes "github.com/aws/aws-sdk-go-v2/service/odb/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_odb_oci_onboarding_status", name="OCI Onboarding Status")
+func newOCIOnboardingStatusDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &ociOnboardingStatusDataSource{}, nil
+}
+
+type ociOnboardingStatusDataSource struct {
+	framework.DataSourceWithModel[ociOnboardingStatusDataSourceModel]
+}
+
+func (d *ociOnboardingStatusDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"existing_tenancy_activation_link": schema.StringAttribute{
+				Computed: true,
+			},
+			"new_tenancy_activation_link": schema.StringAttribute{
+				Computed: true,
+			},
+			"oci_identity_domain": framework.DataSourceComputedListOfObjectAttribute[ociIdentityDomainModel](ctx),
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[odbtypes.OciOnboardingStatus](),
+				Computed:   true,
+			},
+		},
+	}
+}
+
+func (d *ociOnboardingStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ociOnboardingStatusDataSourceModel
+	smerr.AddEnrich(ctx, &resp.Diagnostics, req.Config.Get(ctx, &data))
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().ODBClient(ctx)
+
+	out, err := findOCIOnboardingStatus(ctx, conn)
+	if err != nil {
+		smerr.AddError(ctx, &resp.Diagnostics, err, "operation", "reading ODB OCI onboarding status")
+		return
+	}
+
+	smerr.AddEnrich(ctx, &resp.Diagnostics, fwflex.Flatten(ctx, out, &data))
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &resp.Diagnostics, resp.State.Set(ctx, &data))
+}
+
+type ociOnboardingStatusDataSourceModel struct {
+	framework.WithRegionModel
+	ExistingTenancyActivationLink types.String                                            `tfsdk:"existing_tenancy_activation_link"`
+	NewTenancyActivationLink      types.String                                            `tfsdk:"new_tenancy_activation_link"`
+	OciIdentityDomain             fwtypes.ListNestedObjectValueOf[ociIdentityDomainModel] `tfsdk:"oci_identity_domain"`
+	Status                        fwtypes.StringEnum[odbtypes.OciOnboardingStatus]        `tfsdk:"status"`
+}
+
+type ociIdentityDomainModel struct {
+	AccountSetupCloudFormationUrl types.String                                `tfsdk:"account_setup_cloud_formation_url"`
+	OciIdentityDomainId           types.String                                `tfsdk:"oci_identity_domain_id"`
+	OciIdentityDomainResourceUrl  types.String                                `tfsdk:"oci_identity_domain_resource_url"`
+	OciIdentityDomainUrl          types.String                                `tfsdk:"oci_identity_domain_url"`
+	Status                        fwtypes.StringEnum[odbtypes.ResourceStatus] `tfsdk:"status"`
+	StatusReason                  types.String                                `tfsdk:"status_reason"`
+}
@@ -0,0 +1,130 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package odb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/odb"
+	odbtypes "github.com/aws/aws-sdk-go-v2/service/odb/types"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_odb_reboot_db_node, name="Reboot DB Node")
+func newRebootDBNodeAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &rebootDBNodeAction{}, nil
+}
+
+var (
+	_ action.Action = (*rebootDBNodeAction)(nil)
+)
+
+type rebootDBNodeAction struct {
+	framework.ActionWithModel[dbNodeActionModel]
+}
+
+func (a *rebootDBNodeAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = dbNodeActionSchema("Reboots an Oracle Database@AWS DB node. This action will reboot the DB node and wait for it to return to the AVAILABLE state.", "reboot")
+}
+
+func (a *rebootDBNodeAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config dbNodeActionModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get AWS client
+	conn := a.Meta().ODBClient(ctx)
+
+	cloudVMClusterID := fwflex.StringValueFromFramework(ctx, config.CloudVmClusterId)
+	dbNodeID := fwflex.StringValueFromFramework(ctx, config.DbNodeId)
+
+	// Set default timeout if not provided
+	timeout := fwactions.TimeoutOr(config.Timeout, 30*time.Minute)
+
+	tflog.Info(ctx, "Starting ODB reboot DB node action", map[string]any{
+		"cloud_vm_cluster_id": cloudVMClusterID,
+		"db_node_id":          dbNodeID,
+		names.AttrTimeout:     timeout.String(),
+	})
+
+	// Send initial progress update
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Starting reboot operation for DB node %s...", dbNodeID)
+
+	// Check current DB node status first
+	dbNode, err := findDBNodeByTwoPartKey(ctx, conn, cloudVMClusterID, dbNodeID)
+	if retry.NotFound(err) {
+		resp.Diagnostics.AddError(
+			"DB Node Not Found",
+			fmt.Sprintf("DB node %s was not found in VM cluster %s", dbNodeID, cloudVMClusterID),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Describe DB Node",
+			fmt.Sprintf("Could not describe DB node %s: %s", dbNodeID, err),
+		)
+		return
+	}
+
+	// Only an available DB node can be rebooted
+	if currentStatus := dbNode.Status; currentStatus != odbtypes.DbNodeResourceStatusAvailable {
+		resp.Diagnostics.AddError(
+			"Cannot Reboot DB Node",
+			fmt.Sprintf("DB node %s is in status '%s' and cannot be rebooted. DB node must be in 'AVAILABLE' status.", dbNodeID, currentStatus),
+		)
+		return
+	}
+
+	// Reboot the DB node
+	cb(ctx, "Sending reboot command to DB node %s...", dbNodeID)
+
+	input := odb.RebootDbNodeInput{
+		CloudVmClusterId: aws.String(cloudVMClusterID),
+		DbNodeId:         aws.String(dbNodeID),
+	}
+
+	out, err := conn.RebootDbNode(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Reboot DB Node",
+			fmt.Sprintf("Could not reboot DB node %s: %s", dbNodeID, err),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "DB node status after reboot request", map[string]any{
+		"db_node_id":     dbNodeID,
+		names.AttrStatus: out.Status,
+	})
+
+	cb(ctx, "Reboot command sent to DB node %s, waiting for DB node to restart and become available...", dbNodeID)
+
+	err = waitDBNodeRebooted(ctx, conn, cloudVMClusterID, dbNodeID, out.Status, timeout, cb)
+	if err != nil {
+		addDBNodeActionWaitError(&resp.Diagnostics, err, dbNodeID, "Reboot", timeout)
+		return
+	}
+
+	// Final success message
+	cb(ctx, "DB node %s has been successfully rebooted", dbNodeID)
+
+	tflog.Info(ctx, "ODB reboot DB node action completed successfully", map[string]any{
+		"db_node_id": dbNodeID,
+	})
+}
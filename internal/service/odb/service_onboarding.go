@@ -0,0 +1,241 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package odb
+
+import (
+	"context"
+	"time"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/service/odb"
+	odbtypes "github.com/aws/aws-sdk-go-v2/service/odb/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_odb_service_onboarding", name="Service Onboarding")
+// @SingletonIdentity(identityDuplicateAttributes="id")
+// @Testing(serialize=true, hasNoPreExistingResource=true, generator=false)
+// @Testing(identityTest=false)
+func newServiceOnboardingResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &serviceOnboardingResource{}
+
+	r.SetDefaultCreateTimeout(60 * time.Minute)
+
+	return r, nil
+}
+
+type serviceOnboardingResource struct {
+	framework.ResourceWithModel[serviceOnboardingResourceModel]
+	framework.WithTimeouts
+	framework.WithNoUpdate
+	framework.WithNoOpDelete
+	framework.WithImportByIdentity
+}
+
+func (r *serviceOnboardingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"existing_tenancy_activation_link": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrID: framework.IDAttributeDeprecatedWithAlternate(path.Root(names.AttrRegion)),
+			"marketplace_registration_token": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"new_tenancy_activation_link": schema.StringAttribute{
+				Computed: true,
+			},
+			"oci_identity_domain": schema.BoolAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[odbtypes.OciOnboardingStatus](),
+				Computed:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *serviceOnboardingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data serviceOnboardingResourceModel
+	smerr.AddEnrich(ctx, &resp.Diagnostics, req.Plan.Get(ctx, &data))
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ODBClient(ctx)
+
+	if !data.MarketplaceRegistrationToken.IsNull() {
+		input := odb.AcceptMarketplaceRegistrationInput{
+			MarketplaceRegistrationToken: fwflex.StringFromFramework(ctx, data.MarketplaceRegistrationToken),
+		}
+		_, err := conn.AcceptMarketplaceRegistration(ctx, &input)
+		if err != nil {
+			smerr.AddError(ctx, &resp.Diagnostics, err, "operation", "accepting ODB marketplace registration")
+			return
+		}
+	}
+
+	input := odb.InitializeServiceInput{
+		OciIdentityDomain: fwflex.BoolFromFramework(ctx, data.OciIdentityDomain),
+	}
+	_, err := conn.InitializeService(ctx, &input)
+	if err != nil {
+		smerr.AddError(ctx, &resp.Diagnostics, err, "operation", "initializing ODB service")
+		return
+	}
+
+	out, err := waitServiceOnboardingCreated(ctx, conn, r.CreateTimeout(ctx, data.Timeouts))
+	if err != nil {
+		smerr.AddError(ctx, &resp.Diagnostics, err, "operation", "waiting for ODB service onboarding")
+		return
+	}
+
+	smerr.AddEnrich(ctx, &resp.Diagnostics, fwflex.Flatten(ctx, out, &data, fwflex.WithIgnoredFieldNamesAppend("OciIdentityDomain")))
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.ID = fwflex.StringValueToFramework(ctx, r.Meta().Region(ctx))
+
+	smerr.AddEnrich(ctx, &resp.Diagnostics, resp.State.Set(ctx, &data))
+}
+
+func (r *serviceOnboardingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data serviceOnboardingResourceModel
+	smerr.AddEnrich(ctx, &resp.Diagnostics, req.State.Get(ctx, &data))
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ODBClient(ctx)
+
+	out, err := findServiceOnboarding(ctx, conn)
+	if retry.NotFound(err) {
+		smerr.AddOne(ctx, &resp.Diagnostics, fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		smerr.AddError(ctx, &resp.Diagnostics, err, "operation", "reading ODB service onboarding")
+		return
+	}
+
+	smerr.AddEnrich(ctx, &resp.Diagnostics, fwflex.Flatten(ctx, out, &data, fwflex.WithIgnoredFieldNamesAppend("OciIdentityDomain")))
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &resp.Diagnostics, resp.State.Set(ctx, &data))
+}
+
+func findOCIOnboardingStatus(ctx context.Context, conn *odb.Client) (*odb.GetOciOnboardingStatusOutput, error) {
+	var input odb.GetOciOnboardingStatusInput
+	out, err := conn.GetOciOnboardingStatus(ctx, &input)
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if out == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError())
+	}
+
+	return out, nil
+}
+
+// findServiceOnboarding treats an account that has not started onboarding, or
+// whose onboarding was canceled, as not found.
+func findServiceOnboarding(ctx context.Context, conn *odb.Client) (*odb.GetOciOnboardingStatusOutput, error) {
+	out, err := findOCIOnboardingStatus(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+
+	switch status := out.Status; status {
+	case odbtypes.OciOnboardingStatusNotStarted, odbtypes.OciOnboardingStatusCanceled:
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			Message: string(status),
+		})
+	}
+
+	return out, nil
+}
+
+func statusServiceOnboarding(conn *odb.Client) retry.StateRefreshFunc {
+	return func(ctx context.Context) (any, string, error) {
+		out, err := findOCIOnboardingStatus(ctx, conn)
+		if err != nil {
+			return nil, "", smarterr.NewError(err)
+		}
+
+		return out, string(out.Status), nil
+	}
+}
+
+// waitServiceOnboardingCreated also stops at PENDING_CUSTOMER_ACTION, as
+// onboarding can't progress until the OCI tenancy has been linked using one of
+// the activation links, which are only available to the caller once created.
+func waitServiceOnboardingCreated(ctx context.Context, conn *odb.Client, timeout time.Duration) (*odb.GetOciOnboardingStatusOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(
+			odbtypes.OciOnboardingStatusNotStarted,
+			odbtypes.OciOnboardingStatusPendingLinkGeneration,
+			odbtypes.OciOnboardingStatusPendingInitialization,
+			odbtypes.OciOnboardingStatusActivating,
+		),
+		Target: enum.Slice(
+			odbtypes.OciOnboardingStatusPendingCustomerAction,
+			odbtypes.OciOnboardingStatusActive,
+			odbtypes.OciOnboardingStatusActiveInHomeRegion,
+			odbtypes.OciOnboardingStatusActiveLimited,
+		),
+		Refresh: statusServiceOnboarding(conn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*odb.GetOciOnboardingStatusOutput); ok {
+		return out, smarterr.NewError(err)
+	}
+
+	return nil, smarterr.NewError(err)
+}
+
+type serviceOnboardingResourceModel struct {
+	framework.WithRegionModel
+	ExistingTenancyActivationLink types.String                                     `tfsdk:"existing_tenancy_activation_link"`
+	ID                            types.String                                     `tfsdk:"id"`
+	MarketplaceRegistrationToken  types.String                                     `tfsdk:"marketplace_registration_token"`
+	NewTenancyActivationLink      types.String                                     `tfsdk:"new_tenancy_activation_link"`
+	OciIdentityDomain             types.Bool                                       `tfsdk:"oci_identity_domain"`
+	Status                        fwtypes.StringEnum[odbtypes.OciOnboardingStatus] `tfsdk:"status"`
+	Timeouts                      timeouts.Value                                   `tfsdk:"timeouts"`
+}
@@ -0,0 +1,159 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package odb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/odb"
+	odbtypes "github.com/aws/aws-sdk-go-v2/service/odb/types"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_odb_start_db_node, name="Start DB Node")
+func newStartDBNodeAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &startDBNodeAction{}, nil
+}
+
+var (
+	_ action.Action = (*startDBNodeAction)(nil)
+)
+
+type startDBNodeAction struct {
+	framework.ActionWithModel[dbNodeActionModel]
+}
+
+func (a *startDBNodeAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = dbNodeActionSchema("Starts an Oracle Database@AWS DB node. This action will start the DB node and wait for it to reach the AVAILABLE state.", "start")
+}
+
+func (a *startDBNodeAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config dbNodeActionModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get AWS client
+	conn := a.Meta().ODBClient(ctx)
+
+	cloudVMClusterID := fwflex.StringValueFromFramework(ctx, config.CloudVmClusterId)
+	dbNodeID := fwflex.StringValueFromFramework(ctx, config.DbNodeId)
+
+	// Set default timeout if not provided
+	timeout := fwactions.TimeoutOr(config.Timeout, 30*time.Minute)
+
+	tflog.Info(ctx, "Starting ODB start DB node action", map[string]any{
+		"cloud_vm_cluster_id": cloudVMClusterID,
+		"db_node_id":          dbNodeID,
+		names.AttrTimeout:     timeout.String(),
+	})
+
+	// Send initial progress update
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Starting start operation for DB node %s...", dbNodeID)
+
+	// Check current DB node status first
+	dbNode, err := findDBNodeByTwoPartKey(ctx, conn, cloudVMClusterID, dbNodeID)
+	if retry.NotFound(err) {
+		resp.Diagnostics.AddError(
+			"DB Node Not Found",
+			fmt.Sprintf("DB node %s was not found in VM cluster %s", dbNodeID, cloudVMClusterID),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Describe DB Node",
+			fmt.Sprintf("Could not describe DB node %s: %s", dbNodeID, err),
+		)
+		return
+	}
+
+	currentStatus := dbNode.Status
+	tflog.Debug(ctx, "Current DB node status", map[string]any{
+		"db_node_id":     dbNodeID,
+		names.AttrStatus: currentStatus,
+	})
+
+	// Check if DB node is already available
+	if currentStatus == odbtypes.DbNodeResourceStatusAvailable {
+		cb(ctx, "DB node %s is already available", dbNodeID)
+		tflog.Info(ctx, "DB node already available", map[string]any{
+			"db_node_id": dbNodeID,
+		})
+		return
+	}
+
+	// Check if DB node is in a status that can be started
+	if !canStartDBNode(currentStatus) {
+		resp.Diagnostics.AddError(
+			"Cannot Start DB Node",
+			fmt.Sprintf("DB node %s is in status '%s' and cannot be started. DB node must be in 'STOPPED' or 'STARTING' status.", dbNodeID, currentStatus),
+		)
+		return
+	}
+
+	// If DB node is already starting, just wait for it
+	if currentStatus == odbtypes.DbNodeResourceStatusStarting {
+		cb(ctx, "DB node %s is already starting, waiting for completion...", dbNodeID)
+	} else {
+		// Start the DB node
+		cb(ctx, "Sending start command to DB node %s...", dbNodeID)
+
+		input := odb.StartDbNodeInput{
+			CloudVmClusterId: aws.String(cloudVMClusterID),
+			DbNodeId:         aws.String(dbNodeID),
+		}
+
+		_, err = conn.StartDbNode(ctx, &input)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to Start DB Node",
+				fmt.Sprintf("Could not start DB node %s: %s", dbNodeID, err),
+			)
+			return
+		}
+
+		cb(ctx, "Start command sent to DB node %s, waiting for DB node to become available...", dbNodeID)
+	}
+
+	err = waitDBNodeActionStatus(ctx, conn, cloudVMClusterID, dbNodeID, timeout, cb,
+		odbtypes.DbNodeResourceStatusAvailable,
+		odbtypes.DbNodeResourceStatusStopped,
+		odbtypes.DbNodeResourceStatusStarting,
+	)
+	if err != nil {
+		addDBNodeActionWaitError(&resp.Diagnostics, err, dbNodeID, "Start", timeout)
+		return
+	}
+
+	// Final success message
+	cb(ctx, "DB node %s has been successfully started", dbNodeID)
+
+	tflog.Info(ctx, "ODB start DB node action completed successfully", map[string]any{
+		"db_node_id": dbNodeID,
+	})
+}
+
+// canStartDBNode checks if a DB node can be started based on its current status
+func canStartDBNode(status odbtypes.DbNodeResourceStatus) bool {
+	switch status {
+	case odbtypes.DbNodeResourceStatusStopped, odbtypes.DbNodeResourceStatusStarting:
+		return true
+	default:
+		return false
+	}
+}
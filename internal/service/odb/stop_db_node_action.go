@@ -0,0 +1,159 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package odb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/odb"
+	odbtypes "github.com/aws/aws-sdk-go-v2/service/odb/types"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_odb_stop_db_node, name="Stop DB Node")
+func newStopDBNodeAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &stopDBNodeAction{}, nil
+}
+
+var (
+	_ action.Action = (*stopDBNodeAction)(nil)
+)
+
+type stopDBNodeAction struct {
+	framework.ActionWithModel[dbNodeActionModel]
+}
+
+func (a *stopDBNodeAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = dbNodeActionSchema("Stops an Oracle Database@AWS DB node. This action will stop the DB node and wait for it to reach the STOPPED state.", "stop")
+}
+
+func (a *stopDBNodeAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config dbNodeActionModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get AWS client
+	conn := a.Meta().ODBClient(ctx)
+
+	cloudVMClusterID := fwflex.StringValueFromFramework(ctx, config.CloudVmClusterId)
+	dbNodeID := fwflex.StringValueFromFramework(ctx, config.DbNodeId)
+
+	// Set default timeout if not provided
+	timeout := fwactions.TimeoutOr(config.Timeout, 30*time.Minute)
+
+	tflog.Info(ctx, "Starting ODB stop DB node action", map[string]any{
+		"cloud_vm_cluster_id": cloudVMClusterID,
+		"db_node_id":          dbNodeID,
+		names.AttrTimeout:     timeout.String(),
+	})
+
+	// Send initial progress update
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Starting stop operation for DB node %s...", dbNodeID)
+
+	// Check current DB node status first
+	dbNode, err := findDBNodeByTwoPartKey(ctx, conn, cloudVMClusterID, dbNodeID)
+	if retry.NotFound(err) {
+		resp.Diagnostics.AddError(
+			"DB Node Not Found",
+			fmt.Sprintf("DB node %s was not found in VM cluster %s", dbNodeID, cloudVMClusterID),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Describe DB Node",
+			fmt.Sprintf("Could not describe DB node %s: %s", dbNodeID, err),
+		)
+		return
+	}
+
+	currentStatus := dbNode.Status
+	tflog.Debug(ctx, "Current DB node status", map[string]any{
+		"db_node_id":     dbNodeID,
+		names.AttrStatus: currentStatus,
+	})
+
+	// Check if DB node is already stopped
+	if currentStatus == odbtypes.DbNodeResourceStatusStopped {
+		cb(ctx, "DB node %s is already stopped", dbNodeID)
+		tflog.Info(ctx, "DB node already stopped", map[string]any{
+			"db_node_id": dbNodeID,
+		})
+		return
+	}
+
+	// Check if DB node is in a status that can be stopped
+	if !canStopDBNode(currentStatus) {
+		resp.Diagnostics.AddError(
+			"Cannot Stop DB Node",
+			fmt.Sprintf("DB node %s is in status '%s' and cannot be stopped. DB node must be in 'AVAILABLE' or 'STOPPING' status.", dbNodeID, currentStatus),
+		)
+		return
+	}
+
+	// If DB node is already stopping, just wait for it
+	if currentStatus == odbtypes.DbNodeResourceStatusStopping {
+		cb(ctx, "DB node %s is already stopping, waiting for completion...", dbNodeID)
+	} else {
+		// Stop the DB node
+		cb(ctx, "Sending stop command to DB node %s...", dbNodeID)
+
+		input := odb.StopDbNodeInput{
+			CloudVmClusterId: aws.String(cloudVMClusterID),
+			DbNodeId:         aws.String(dbNodeID),
+		}
+
+		_, err = conn.StopDbNode(ctx, &input)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to Stop DB Node",
+				fmt.Sprintf("Could not stop DB node %s: %s", dbNodeID, err),
+			)
+			return
+		}
+
+		cb(ctx, "Stop command sent to DB node %s, waiting for DB node to stop...", dbNodeID)
+	}
+
+	err = waitDBNodeActionStatus(ctx, conn, cloudVMClusterID, dbNodeID, timeout, cb,
+		odbtypes.DbNodeResourceStatusStopped,
+		odbtypes.DbNodeResourceStatusAvailable,
+		odbtypes.DbNodeResourceStatusStopping,
+	)
+	if err != nil {
+		addDBNodeActionWaitError(&resp.Diagnostics, err, dbNodeID, "Stop", timeout)
+		return
+	}
+
+	// Final success message
+	cb(ctx, "DB node %s has been successfully stopped", dbNodeID)
+
+	tflog.Info(ctx, "ODB stop DB node action completed successfully", map[string]any{
+		"db_node_id": dbNodeID,
+	})
+}
+
+// canStopDBNode checks if a DB node can be stopped based on its current status
+func canStopDBNode(status odbtypes.DbNodeResourceStatus) bool {
+	switch status {
+	case odbtypes.DbNodeResourceStatusAvailable, odbtypes.DbNodeResourceStatusStopping:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package odb_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	odbtypes "github.com/aws/aws-sdk-go-v2/service/odb/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfodb "github.com/hashicorp/terraform-provider-aws/internal/service/odb"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Acceptance test access AWS and cost money to run.
+func TestAccODBStopDBNodeAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dataSourceName := "data.aws_odb_db_nodes.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ODBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             dbNodeDataSourceTestEntity.testAccCheckDBNodeDestroyed(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStopDBNodeActionConfig_basic(publicKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDBNodeStatus(ctx, dataSourceName, odbtypes.DbNodeResourceStatusStopped),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDBNodeStatus(ctx context.Context, name string, expected odbtypes.DbNodeResourceStatus) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		cloudVMClusterID := rs.Primary.Attributes["cloud_vm_cluster_id"]
+		dbNodeID := rs.Primary.Attributes["db_nodes.0.id"]
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ODBClient(ctx)
+
+		dbNode, err := tfodb.FindDBNodeByTwoPartKey(ctx, conn, cloudVMClusterID, dbNodeID)
+		if err != nil {
+			return err
+		}
+
+		if dbNode.Status != expected {
+			return fmt.Errorf("Expected DB node %s status %s, got %s", dbNodeID, expected, dbNode.Status)
+		}
+
+		return nil
+	}
+}
+
+func testAccStopDBNodeActionConfig_basic(publicKey string) string {
+	return fmt.Sprintf(`
+%s
+
+data "aws_odb_db_nodes" "test" {
+  cloud_vm_cluster_id = aws_odb_cloud_vm_cluster.test.id
+}
+
+action "aws_odb_stop_db_node" "test" {
+  config {
+    cloud_vm_cluster_id = aws_odb_cloud_vm_cluster.test.id
+    db_node_id          = data.aws_odb_db_nodes.test.db_nodes[0].id
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_odb_stop_db_node.test]
+    }
+  }
+}
+`, dbNodeDataSourceTestEntity.vmClusterBasicConfig(publicKey))
+}
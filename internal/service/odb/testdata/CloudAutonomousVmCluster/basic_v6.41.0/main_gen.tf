@@ -0,0 +1,70 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_odb_cloud_autonomous_vm_cluster" "test" {
+  cloud_exadata_infrastructure_id       = aws_odb_cloud_exadata_infrastructure.test.id
+  odb_network_id                        = aws_odb_network.test.id
+  display_name                          = var.rName
+  autonomous_data_storage_size_in_tbs   = 5
+  memory_per_oracle_compute_unit_in_gbs = 2
+  total_container_databases             = 1
+  cpu_core_count_per_node               = 40
+  license_model                         = "LICENSE_INCLUDED"
+  db_servers                            = [for db_server in data.aws_odb_db_servers.test.db_servers : db_server.id]
+  scan_listener_port_tls                = 8561
+  scan_listener_port_non_tls            = 1024
+
+  maintenance_window {
+    preference = "NO_PREFERENCE"
+  }
+}
+
+data "aws_odb_db_servers" "test" {
+  cloud_exadata_infrastructure_id = aws_odb_cloud_exadata_infrastructure.test.id
+}
+
+resource "aws_odb_cloud_exadata_infrastructure" "test" {
+  display_name                     = var.rName
+  shape                            = "Exadata.X9M"
+  storage_count                    = 3
+  compute_count                    = 2
+  availability_zone_id             = "use1-az6"
+  customer_contacts_to_send_to_oci = [{ email = var.address }]
+
+  maintenance_window {
+    custom_action_timeout_in_mins    = 16
+    is_custom_action_timeout_enabled = true
+    patching_mode                    = "ROLLING"
+    preference                       = "NO_PREFERENCE"
+  }
+}
+
+resource "aws_odb_network" "test" {
+  display_name         = var.rName
+  availability_zone_id = "use1-az6"
+  client_subnet_cidr   = "10.2.0.0/24"
+  backup_subnet_cidr   = "10.2.1.0/24"
+  s3_access            = "DISABLED"
+  zero_etl_access      = "DISABLED"
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+variable "address" {
+  type     = string
+  nullable = false
+}
+
+terraform {
+  required_providers {
+    aws = {
+      source  = "hashicorp/aws"
+      version = "6.41.0"
+    }
+  }
+}
+
+provider "aws" {}
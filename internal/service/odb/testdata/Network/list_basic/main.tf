@@ -0,0 +1,26 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_odb_network" "test" {
+  count = var.resource_count
+
+  display_name                = "${var.rName}-${count.index}"
+  availability_zone_id        = "use1-az6"
+  client_subnet_cidr          = "10.2.${count.index * 2}.0/24"
+  backup_subnet_cidr          = "10.2.${count.index * 2 + 1}.0/24"
+  s3_access                   = "DISABLED"
+  zero_etl_access             = "DISABLED"
+  delete_associated_resources = true
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "resource_count" {
+  description = "Number of resources to create"
+  type        = number
+  nullable    = false
+}
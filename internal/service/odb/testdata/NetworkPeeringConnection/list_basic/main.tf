@@ -0,0 +1,41 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_odb_network_peering_connection" "test" {
+  count = var.resource_count
+
+  display_name    = "${var.rName}-${count.index}"
+  odb_network_id  = aws_odb_network.test.id
+  peer_network_id = aws_vpc.test[count.index].id
+}
+
+resource "aws_vpc" "test" {
+  count = var.resource_count
+
+  cidr_block = "10.${count.index}.0.0/16"
+
+  tags = {
+    Name = "${var.rName}-${count.index}"
+  }
+}
+
+resource "aws_odb_network" "test" {
+  display_name         = var.rName
+  availability_zone_id = "use1-az6"
+  client_subnet_cidr   = "10.2.0.0/24"
+  backup_subnet_cidr   = "10.2.1.0/24"
+  s3_access            = "DISABLED"
+  zero_etl_access      = "DISABLED"
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "resource_count" {
+  description = "Number of resources to create"
+  type        = number
+  nullable    = false
+}
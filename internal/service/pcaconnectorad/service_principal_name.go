@@ -0,0 +1,286 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package pcaconnectorad
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pcaconnectorad"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pcaconnectorad/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_pcaconnectorad_service_principal_name", name="Service Principal Name")
+// @IdentityAttribute("connector_arn")
+// @IdentityAttribute("directory_registration_arn")
+// @ImportIDHandler("servicePrincipalNameImportID")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/pcaconnectorad/types;awstypes;awstypes.ServicePrincipalName")
+// @Testing(importStateIdAttributes="connector_arn;directory_registration_arn", importStateIdAttributesSep="flex.ResourceIdSeparator")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(hasNoPreExistingResource=true)
+func newServicePrincipalNameResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &servicePrincipalNameResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type servicePrincipalNameResource struct {
+	framework.ResourceWithModel[servicePrincipalNameResourceModel]
+	framework.WithNoUpdate
+	framework.WithTimeouts
+	framework.WithImportByIdentity
+}
+
+func (r *servicePrincipalNameResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"connector_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"directory_registration_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *servicePrincipalNameResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan servicePrincipalNameResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	connectorARN, directoryRegistrationARN := fwflex.StringValueFromFramework(ctx, plan.ConnectorARN), fwflex.StringValueFromFramework(ctx, plan.DirectoryRegistrationARN)
+	var input pcaconnectorad.CreateServicePrincipalNameInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, plan, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.CreateServicePrincipalName(ctx, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, connectorARN)
+		return
+	}
+
+	if _, err := waitServicePrincipalNameCreated(ctx, conn, connectorARN, directoryRegistrationARN, r.CreateTimeout(ctx, plan.Timeouts)); err != nil {
+		smerr.AddEnrich(ctx, &response.Diagnostics, response.State.SetAttribute(ctx, path.Root("connector_arn"), plan.ConnectorARN))
+		smerr.AddEnrich(ctx, &response.Diagnostics, response.State.SetAttribute(ctx, path.Root("directory_registration_arn"), plan.DirectoryRegistrationARN))
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, connectorARN)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, plan))
+}
+
+func (r *servicePrincipalNameResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data servicePrincipalNameResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	connectorARN, directoryRegistrationARN := fwflex.StringValueFromFramework(ctx, data.ConnectorARN), fwflex.StringValueFromFramework(ctx, data.DirectoryRegistrationARN)
+	output, err := findServicePrincipalNameByTwoPartKey(ctx, conn, connectorARN, directoryRegistrationARN)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, connectorARN)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *servicePrincipalNameResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data servicePrincipalNameResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	connectorARN, directoryRegistrationARN := fwflex.StringValueFromFramework(ctx, data.ConnectorARN), fwflex.StringValueFromFramework(ctx, data.DirectoryRegistrationARN)
+	input := pcaconnectorad.DeleteServicePrincipalNameInput{
+		ConnectorArn:             aws.String(connectorARN),
+		DirectoryRegistrationArn: aws.String(directoryRegistrationARN),
+	}
+	_, err := conn.DeleteServicePrincipalName(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, connectorARN)
+		return
+	}
+
+	if _, err := waitServicePrincipalNameDeleted(ctx, conn, connectorARN, directoryRegistrationARN, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, connectorARN)
+		return
+	}
+}
+
+var (
+	_ inttypes.ImportIDParser = servicePrincipalNameImportID{}
+)
+
+type servicePrincipalNameImportID struct{}
+
+func (servicePrincipalNameImportID) Parse(id string) (string, map[string]any, error) {
+	connectorARN, directoryRegistrationARN, found := strings.Cut(id, intflex.ResourceIdSeparator)
+	if !found {
+		return "", nil, fmt.Errorf("id %q should be in the format <connector-arn>%s<directory-registration-arn>", id, intflex.ResourceIdSeparator)
+	}
+
+	result := map[string]any{
+		"connector_arn":              connectorARN,
+		"directory_registration_arn": directoryRegistrationARN,
+	}
+
+	return id, result, nil
+}
+
+func findServicePrincipalNameByTwoPartKey(ctx context.Context, conn *pcaconnectorad.Client, connectorARN, directoryRegistrationARN string) (*awstypes.ServicePrincipalName, error) {
+	input := pcaconnectorad.GetServicePrincipalNameInput{
+		ConnectorArn:             aws.String(connectorARN),
+		DirectoryRegistrationArn: aws.String(directoryRegistrationARN),
+	}
+	output, err := conn.GetServicePrincipalName(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil || output.ServicePrincipalName == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError())
+	}
+
+	return output.ServicePrincipalName, nil
+}
+
+func statusServicePrincipalName(conn *pcaconnectorad.Client, connectorARN, directoryRegistrationARN string) retry.StateRefreshFunc {
+	return func(ctx context.Context) (any, string, error) {
+		output, err := findServicePrincipalNameByTwoPartKey(ctx, conn, connectorARN, directoryRegistrationARN)
+
+		if retry.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitServicePrincipalNameCreated(ctx context.Context, conn *pcaconnectorad.Client, connectorARN, directoryRegistrationARN string, timeout time.Duration) (*awstypes.ServicePrincipalName, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ServicePrincipalNameStatusCreating),
+		Target:  enum.Slice(awstypes.ServicePrincipalNameStatusActive),
+		Refresh: statusServicePrincipalName(conn, connectorARN, directoryRegistrationARN),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ServicePrincipalName); ok {
+		retry.SetLastError(err, errors.New(string(output.StatusReason)))
+
+		return output, smarterr.NewError(err)
+	}
+
+	return nil, smarterr.NewError(err)
+}
+
+func waitServicePrincipalNameDeleted(ctx context.Context, conn *pcaconnectorad.Client, connectorARN, directoryRegistrationARN string, timeout time.Duration) (*awstypes.ServicePrincipalName, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ServicePrincipalNameStatusDeleting, awstypes.ServicePrincipalNameStatusActive),
+		Target:  []string{},
+		Refresh: statusServicePrincipalName(conn, connectorARN, directoryRegistrationARN),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ServicePrincipalName); ok {
+		retry.SetLastError(err, errors.New(string(output.StatusReason)))
+
+		return output, smarterr.NewError(err)
+	}
+
+	return nil, smarterr.NewError(err)
+}
+
+type servicePrincipalNameResourceModel struct {
+	framework.WithRegionModel
+	ConnectorARN             fwtypes.ARN    `tfsdk:"connector_arn"`
+	DirectoryRegistrationARN fwtypes.ARN    `tfsdk:"directory_registration_arn"`
+	Timeouts                 timeouts.Value `tfsdk:"timeouts"`
+}
@@ -0,0 +1,147 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package pcaconnectorad_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pcaconnectorad/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfpcaconnectorad "github.com/hashicorp/terraform-provider-aws/internal/service/pcaconnectorad"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccPCAConnectorADServicePrincipalName_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ServicePrincipalName
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_pcaconnectorad_service_principal_name.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.PCAConnectorADServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServicePrincipalNameDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServicePrincipalNameConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServicePrincipalNameExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "connector_arn", "aws_pcaconnectorad_connector.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "directory_registration_arn", "aws_pcaconnectorad_directory_registration.test", names.AttrARN),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateIdFunc:                    acctest.AttrsImportStateIdFunc(resourceName, ",", "connector_arn", "directory_registration_arn"),
+				ImportStateVerifyIdentifierAttribute: "connector_arn",
+				ImportStateVerifyIgnore:              []string{names.AttrTimeouts},
+			},
+		},
+	})
+}
+
+func TestAccPCAConnectorADServicePrincipalName_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ServicePrincipalName
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_pcaconnectorad_service_principal_name.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.PCAConnectorADServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServicePrincipalNameDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServicePrincipalNameConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServicePrincipalNameExists(ctx, t, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, t, tfpcaconnectorad.ResourceServicePrincipalName, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckServicePrincipalNameDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).PCAConnectorADClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_pcaconnectorad_service_principal_name" {
+				continue
+			}
+
+			_, err := tfpcaconnectorad.FindServicePrincipalNameByTwoPartKey(ctx, conn, rs.Primary.Attributes["connector_arn"], rs.Primary.Attributes["directory_registration_arn"])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Private CA Connector for Active Directory Service Principal Name %s still exists", rs.Primary.Attributes["connector_arn"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckServicePrincipalNameExists(ctx context.Context, t *testing.T, n string, v *awstypes.ServicePrincipalName) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).PCAConnectorADClient(ctx)
+
+		output, err := tfpcaconnectorad.FindServicePrincipalNameByTwoPartKey(ctx, conn, rs.Primary.Attributes["connector_arn"], rs.Primary.Attributes["directory_registration_arn"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccServicePrincipalNameConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccConnectorConfig_basic(rName), `
+resource "aws_pcaconnectorad_service_principal_name" "test" {
+  connector_arn              = aws_pcaconnectorad_connector.test.arn
+  directory_registration_arn = aws_pcaconnectorad_directory_registration.test.arn
+}
+`)
+}
@@ -0,0 +1,210 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package pcaconnectorad_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pcaconnectorad/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfpcaconnectorad "github.com/hashicorp/terraform-provider-aws/internal/service/pcaconnectorad"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccPCAConnectorADTemplateGroupAccessControlEntry_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.AccessControlEntry
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_pcaconnectorad_template_group_access_control_entry.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.PCAConnectorADServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateGroupAccessControlEntryDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateGroupAccessControlEntryConfig_basic(rName, "Authenticated Users", "ALLOW"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTemplateGroupAccessControlEntryExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "template_arn", "aws_pcaconnectorad_template.test", names.AttrARN),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("access_rights"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"auto_enroll": knownvalue.StringExact("ALLOW"),
+							"enroll":      knownvalue.StringExact("ALLOW"),
+						}),
+					})),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("group_display_name"), knownvalue.StringExact("Authenticated Users")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("group_security_identifier"), knownvalue.StringExact("S-1-5-11")),
+				},
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateIdFunc:                    acctest.AttrsImportStateIdFunc(resourceName, ",", "template_arn", "group_security_identifier"),
+				ImportStateVerifyIdentifierAttribute: "template_arn",
+			},
+		},
+	})
+}
+
+func TestAccPCAConnectorADTemplateGroupAccessControlEntry_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.AccessControlEntry
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_pcaconnectorad_template_group_access_control_entry.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.PCAConnectorADServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateGroupAccessControlEntryDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateGroupAccessControlEntryConfig_basic(rName, "Authenticated Users", "ALLOW"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTemplateGroupAccessControlEntryExists(ctx, t, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, t, tfpcaconnectorad.ResourceTemplateGroupAccessControlEntry, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccPCAConnectorADTemplateGroupAccessControlEntry_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.AccessControlEntry
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_pcaconnectorad_template_group_access_control_entry.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.PCAConnectorADServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateGroupAccessControlEntryDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateGroupAccessControlEntryConfig_basic(rName, "Authenticated Users", "ALLOW"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTemplateGroupAccessControlEntryExists(ctx, t, resourceName, &v),
+				),
+			},
+			{
+				Config: testAccTemplateGroupAccessControlEntryConfig_basic(rName, "All Authenticated Users", "DENY"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTemplateGroupAccessControlEntryExists(ctx, t, resourceName, &v),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("access_rights"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"auto_enroll": knownvalue.StringExact("DENY"),
+							"enroll":      knownvalue.StringExact("ALLOW"),
+						}),
+					})),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("group_display_name"), knownvalue.StringExact("All Authenticated Users")),
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckTemplateGroupAccessControlEntryDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).PCAConnectorADClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_pcaconnectorad_template_group_access_control_entry" {
+				continue
+			}
+
+			_, err := tfpcaconnectorad.FindTemplateGroupAccessControlEntryByTwoPartKey(ctx, conn, rs.Primary.Attributes["template_arn"], rs.Primary.Attributes["group_security_identifier"])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Private CA Connector for Active Directory Template Group Access Control Entry %s still exists", rs.Primary.Attributes["group_security_identifier"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTemplateGroupAccessControlEntryExists(ctx context.Context, t *testing.T, n string, v *awstypes.AccessControlEntry) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).PCAConnectorADClient(ctx)
+
+		output, err := tfpcaconnectorad.FindTemplateGroupAccessControlEntryByTwoPartKey(ctx, conn, rs.Primary.Attributes["template_arn"], rs.Primary.Attributes["group_security_identifier"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+// The "Authenticated Users" well-known SID is used so that no Active Directory group needs to be created.
+func testAccTemplateGroupAccessControlEntryConfig_basic(rName, groupDisplayName, autoEnroll string) string {
+	return acctest.ConfigCompose(testAccTemplateConfig_basic(rName, 6), fmt.Sprintf(`
+resource "aws_pcaconnectorad_template_group_access_control_entry" "test" {
+  template_arn              = aws_pcaconnectorad_template.test.arn
+  group_display_name        = %[1]q
+  group_security_identifier = "S-1-5-11"
+
+  access_rights {
+    auto_enroll = %[2]q
+    enroll      = "ALLOW"
+  }
+}
+`, groupDisplayName, autoEnroll))
+}
@@ -0,0 +1,555 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package pcs
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pcs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pcs/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_pcs_compute_node_group", name="Compute Node Group")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("cluster_id")
+// @IdentityAttribute("id")
+// @ImportIDHandler("computeNodeGroupImportID")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/pcs/types;awstypes;awstypes.ComputeNodeGroup")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(importStateIdFunc=testAccComputeNodeGroupImportStateIDFunc)
+// @Testing(importStateIdAttribute="id")
+// @Testing(hasNoPreExistingResource=true)
+func newComputeNodeGroupResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &computeNodeGroupResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type computeNodeGroupResource struct {
+	framework.ResourceWithModel[computeNodeGroupResourceModel]
+	framework.WithTimeouts
+	framework.WithImportByIdentity
+}
+
+func (r *computeNodeGroupResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"ami_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"cluster_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"iam_instance_profile_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"purchase_option": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PurchaseOption](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrSubnetIDs: schema.SetAttribute{
+				CustomType: fwtypes.SetOfStringType,
+				Required:   true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"custom_launch_template": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[customLaunchTemplateModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrID: schema.StringAttribute{
+							Required: true,
+						},
+						names.AttrVersion: schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"instance_configs": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[instanceConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrInstanceType: schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"scaling_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[scalingConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_instance_count": schema.Int64Attribute{
+							Required: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+						"min_instance_count": schema.Int64Attribute{
+							Required: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+					},
+				},
+			},
+			"slurm_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[computeNodeGroupSlurmConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"scale_down_idle_time_in_seconds": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+							PlanModifiers: []planmodifier.Int64{
+								int64planmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"slurm_custom_settings": slurmCustomSettingsBlock(ctx),
+					},
+				},
+			},
+			"spot_options": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[spotOptionsModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"allocation_strategy": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.SpotAllocationStrategy](),
+							Optional:   true,
+							Computed:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *computeNodeGroupResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan computeNodeGroupResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCSClient(ctx)
+
+	clusterID, name := fwflex.StringValueFromFramework(ctx, plan.ClusterID), fwflex.StringValueFromFramework(ctx, plan.Name)
+	var input pcs.CreateComputeNodeGroupInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, plan, &input, fwflex.WithFieldNamePrefix("ComputeNodeGroup")))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClusterIdentifier = aws.String(clusterID)
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateComputeNodeGroup(ctx, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	id := aws.ToString(output.ComputeNodeGroup.Id)
+	computeNodeGroup, err := waitComputeNodeGroupCreated(ctx, conn, clusterID, id, r.CreateTimeout(ctx, plan.Timeouts))
+
+	if err != nil {
+		smerr.AddEnrich(ctx, &response.Diagnostics, response.State.SetAttribute(ctx, path.Root("cluster_id"), clusterID))
+		smerr.AddEnrich(ctx, &response.Diagnostics, response.State.SetAttribute(ctx, path.Root(names.AttrID), id))
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, computeNodeGroup, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, plan))
+}
+
+func (r *computeNodeGroupResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data computeNodeGroupResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCSClient(ctx)
+
+	clusterID, id := fwflex.StringValueFromFramework(ctx, data.ClusterID), fwflex.StringValueFromFramework(ctx, data.ID)
+	output, err := findComputeNodeGroupByTwoPartKey(ctx, conn, clusterID, id)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *computeNodeGroupResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old computeNodeGroupResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &new))
+	if response.Diagnostics.HasError() {
+		return
+	}
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &old))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCSClient(ctx)
+
+	diff, d := fwflex.Diff(ctx, new, old)
+	smerr.AddEnrich(ctx, &response.Diagnostics, d)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		clusterID, id := fwflex.StringValueFromFramework(ctx, new.ClusterID), fwflex.StringValueFromFramework(ctx, new.ID)
+		var input pcs.UpdateComputeNodeGroupInput
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, new, &input))
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.ClusterIdentifier = aws.String(clusterID)
+		input.ComputeNodeGroupIdentifier = aws.String(id)
+
+		_, err := conn.UpdateComputeNodeGroup(ctx, &input)
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+			return
+		}
+
+		computeNodeGroup, err := waitComputeNodeGroupUpdated(ctx, conn, clusterID, id, r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+			return
+		}
+
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, computeNodeGroup, &new))
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &new))
+}
+
+func (r *computeNodeGroupResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data computeNodeGroupResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCSClient(ctx)
+
+	clusterID, id := fwflex.StringValueFromFramework(ctx, data.ClusterID), fwflex.StringValueFromFramework(ctx, data.ID)
+	input := pcs.DeleteComputeNodeGroupInput{
+		ClusterIdentifier:          aws.String(clusterID),
+		ComputeNodeGroupIdentifier: aws.String(id),
+	}
+	_, err := conn.DeleteComputeNodeGroup(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	if _, err := waitComputeNodeGroupDeleted(ctx, conn, clusterID, id, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+}
+
+var (
+	_ inttypes.ImportIDParser = computeNodeGroupImportID{}
+)
+
+type computeNodeGroupImportID struct{}
+
+const (
+	computeNodeGroupIDParts = 2
+)
+
+func (computeNodeGroupImportID) Parse(id string) (string, map[string]any, error) {
+	parts, err := intflex.ExpandResourceId(id, computeNodeGroupIDParts, false)
+	if err != nil {
+		return "", nil, err
+	}
+
+	result := map[string]any{
+		"cluster_id": parts[0],
+		names.AttrID: parts[1],
+	}
+
+	return id, result, nil
+}
+
+func findComputeNodeGroupByTwoPartKey(ctx context.Context, conn *pcs.Client, clusterID, id string) (*awstypes.ComputeNodeGroup, error) {
+	input := pcs.GetComputeNodeGroupInput{
+		ClusterIdentifier:          aws.String(clusterID),
+		ComputeNodeGroupIdentifier: aws.String(id),
+	}
+	output, err := conn.GetComputeNodeGroup(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil || output.ComputeNodeGroup == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError())
+	}
+
+	if status := output.ComputeNodeGroup.Status; status == awstypes.ComputeNodeGroupStatusDeleted {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			Message: string(status),
+		})
+	}
+
+	return output.ComputeNodeGroup, nil
+}
+
+func statusComputeNodeGroup(conn *pcs.Client, clusterID, id string) retry.StateRefreshFunc {
+	return func(ctx context.Context) (any, string, error) {
+		output, err := findComputeNodeGroupByTwoPartKey(ctx, conn, clusterID, id)
+
+		if retry.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitComputeNodeGroupCreated(ctx context.Context, conn *pcs.Client, clusterID, id string, timeout time.Duration) (*awstypes.ComputeNodeGroup, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ComputeNodeGroupStatusCreating),
+		Target:  enum.Slice(awstypes.ComputeNodeGroupStatusActive),
+		Refresh: statusComputeNodeGroup(conn, clusterID, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ComputeNodeGroup); ok {
+		retry.SetLastError(err, errors.Join(tfslices.ApplyToAll(output.ErrorInfo, errorInfoError)...))
+
+		return output, smarterr.NewError(err)
+	}
+
+	return nil, smarterr.NewError(err)
+}
+
+func waitComputeNodeGroupUpdated(ctx context.Context, conn *pcs.Client, clusterID, id string, timeout time.Duration) (*awstypes.ComputeNodeGroup, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ComputeNodeGroupStatusUpdating),
+		Target:  enum.Slice(awstypes.ComputeNodeGroupStatusActive),
+		Refresh: statusComputeNodeGroup(conn, clusterID, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ComputeNodeGroup); ok {
+		retry.SetLastError(err, errors.Join(tfslices.ApplyToAll(output.ErrorInfo, errorInfoError)...))
+
+		return output, smarterr.NewError(err)
+	}
+
+	return nil, smarterr.NewError(err)
+}
+
+func waitComputeNodeGroupDeleted(ctx context.Context, conn *pcs.Client, clusterID, id string, timeout time.Duration) (*awstypes.ComputeNodeGroup, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ComputeNodeGroupStatusDeleting),
+		Target:  []string{},
+		Refresh: statusComputeNodeGroup(conn, clusterID, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ComputeNodeGroup); ok {
+		retry.SetLastError(err, errors.Join(tfslices.ApplyToAll(output.ErrorInfo, errorInfoError)...))
+
+		return output, smarterr.NewError(err)
+	}
+
+	return nil, smarterr.NewError(err)
+}
+
+type computeNodeGroupResourceModel struct {
+	framework.WithRegionModel
+	AMIID                 types.String                                                             `tfsdk:"ami_id"`
+	ARN                   types.String                                                             `tfsdk:"arn"`
+	ClusterID             types.String                                                             `tfsdk:"cluster_id"`
+	CustomLaunchTemplate  fwtypes.ListNestedObjectValueOf[customLaunchTemplateModel]               `tfsdk:"custom_launch_template"`
+	IAMInstanceProfileARN fwtypes.ARN                                                              `tfsdk:"iam_instance_profile_arn"`
+	ID                    types.String                                                             `tfsdk:"id"`
+	InstanceConfigs       fwtypes.ListNestedObjectValueOf[instanceConfigModel]                     `tfsdk:"instance_configs"`
+	Name                  types.String                                                             `tfsdk:"name"`
+	PurchaseOption        fwtypes.StringEnum[awstypes.PurchaseOption]                              `tfsdk:"purchase_option"`
+	ScalingConfiguration  fwtypes.ListNestedObjectValueOf[scalingConfigurationModel]               `tfsdk:"scaling_configuration"`
+	SlurmConfiguration    fwtypes.ListNestedObjectValueOf[computeNodeGroupSlurmConfigurationModel] `tfsdk:"slurm_configuration"`
+	SpotOptions           fwtypes.ListNestedObjectValueOf[spotOptionsModel]                        `tfsdk:"spot_options"`
+	SubnetIDs             fwtypes.SetOfString                                                      `tfsdk:"subnet_ids"`
+	Tags                  tftags.Map                                                               `tfsdk:"tags"`
+	TagsAll               tftags.Map                                                               `tfsdk:"tags_all"`
+	Timeouts              timeouts.Value                                                           `tfsdk:"timeouts"`
+}
+
+type customLaunchTemplateModel struct {
+	ID      types.String `tfsdk:"id"`
+	Version types.String `tfsdk:"version"`
+}
+
+type instanceConfigModel struct {
+	InstanceType types.String `tfsdk:"instance_type"`
+}
+
+type scalingConfigurationModel struct {
+	MaxInstanceCount types.Int64 `tfsdk:"max_instance_count"`
+	MinInstanceCount types.Int64 `tfsdk:"min_instance_count"`
+}
+
+type computeNodeGroupSlurmConfigurationModel struct {
+	ScaleDownIdleTimeInSeconds types.Int64                                              `tfsdk:"scale_down_idle_time_in_seconds"`
+	SlurmCustomSettings        fwtypes.ListNestedObjectValueOf[slurmCustomSettingModel] `tfsdk:"slurm_custom_settings"`
+}
+
+type spotOptionsModel struct {
+	AllocationStrategy fwtypes.StringEnum[awstypes.SpotAllocationStrategy] `tfsdk:"allocation_strategy"`
+}
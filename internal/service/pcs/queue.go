@@ -0,0 +1,418 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package pcs
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pcs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pcs/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_pcs_queue", name="Queue")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("cluster_id")
+// @IdentityAttribute("id")
+// @ImportIDHandler("queueImportID")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/pcs/types;awstypes;awstypes.Queue")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(importStateIdFunc=testAccQueueImportStateIDFunc)
+// @Testing(importStateIdAttribute="id")
+// @Testing(hasNoPreExistingResource=true)
+func newQueueResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &queueResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type queueResource struct {
+	framework.ResourceWithModel[queueResourceModel]
+	framework.WithTimeouts
+	framework.WithImportByIdentity
+}
+
+func (r *queueResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"cluster_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"compute_node_group_configurations": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[computeNodeGroupConfigurationModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"compute_node_group_id": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"slurm_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[queueSlurmConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"slurm_custom_settings": slurmCustomSettingsBlock(ctx),
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *queueResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan queueResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCSClient(ctx)
+
+	clusterID, name := fwflex.StringValueFromFramework(ctx, plan.ClusterID), fwflex.StringValueFromFramework(ctx, plan.Name)
+	var input pcs.CreateQueueInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, plan, &input, fwflex.WithFieldNamePrefix("Queue")))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClusterIdentifier = aws.String(clusterID)
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateQueue(ctx, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	id := aws.ToString(output.Queue.Id)
+	queue, err := waitQueueCreated(ctx, conn, clusterID, id, r.CreateTimeout(ctx, plan.Timeouts))
+
+	if err != nil {
+		smerr.AddEnrich(ctx, &response.Diagnostics, response.State.SetAttribute(ctx, path.Root("cluster_id"), clusterID))
+		smerr.AddEnrich(ctx, &response.Diagnostics, response.State.SetAttribute(ctx, path.Root(names.AttrID), id))
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, queue, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, plan))
+}
+
+func (r *queueResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data queueResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCSClient(ctx)
+
+	clusterID, id := fwflex.StringValueFromFramework(ctx, data.ClusterID), fwflex.StringValueFromFramework(ctx, data.ID)
+	output, err := findQueueByTwoPartKey(ctx, conn, clusterID, id)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *queueResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old queueResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &new))
+	if response.Diagnostics.HasError() {
+		return
+	}
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &old))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCSClient(ctx)
+
+	diff, d := fwflex.Diff(ctx, new, old)
+	smerr.AddEnrich(ctx, &response.Diagnostics, d)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		clusterID, id := fwflex.StringValueFromFramework(ctx, new.ClusterID), fwflex.StringValueFromFramework(ctx, new.ID)
+		var input pcs.UpdateQueueInput
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, new, &input))
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.ClusterIdentifier = aws.String(clusterID)
+		input.QueueIdentifier = aws.String(id)
+
+		_, err := conn.UpdateQueue(ctx, &input)
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+			return
+		}
+
+		queue, err := waitQueueUpdated(ctx, conn, clusterID, id, r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+			return
+		}
+
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, queue, &new))
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &new))
+}
+
+func (r *queueResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data queueResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCSClient(ctx)
+
+	clusterID, id := fwflex.StringValueFromFramework(ctx, data.ClusterID), fwflex.StringValueFromFramework(ctx, data.ID)
+	input := pcs.DeleteQueueInput{
+		ClusterIdentifier: aws.String(clusterID),
+		QueueIdentifier:   aws.String(id),
+	}
+	_, err := conn.DeleteQueue(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	if _, err := waitQueueDeleted(ctx, conn, clusterID, id, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+}
+
+var (
+	_ inttypes.ImportIDParser = queueImportID{}
+)
+
+type queueImportID struct{}
+
+const (
+	queueIDParts = 2
+)
+
+func (queueImportID) Parse(id string) (string, map[string]any, error) {
+	parts, err := intflex.ExpandResourceId(id, queueIDParts, false)
+	if err != nil {
+		return "", nil, err
+	}
+
+	result := map[string]any{
+		"cluster_id": parts[0],
+		names.AttrID: parts[1],
+	}
+
+	return id, result, nil
+}
+
+func findQueueByTwoPartKey(ctx context.Context, conn *pcs.Client, clusterID, id string) (*awstypes.Queue, error) {
+	input := pcs.GetQueueInput{
+		ClusterIdentifier: aws.String(clusterID),
+		QueueIdentifier:   aws.String(id),
+	}
+	output, err := conn.GetQueue(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil || output.Queue == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError())
+	}
+
+	return output.Queue, nil
+}
+
+func statusQueue(conn *pcs.Client, clusterID, id string) retry.StateRefreshFunc {
+	return func(ctx context.Context) (any, string, error) {
+		output, err := findQueueByTwoPartKey(ctx, conn, clusterID, id)
+
+		if retry.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitQueueCreated(ctx context.Context, conn *pcs.Client, clusterID, id string, timeout time.Duration) (*awstypes.Queue, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.QueueStatusCreating),
+		Target:  enum.Slice(awstypes.QueueStatusActive),
+		Refresh: statusQueue(conn, clusterID, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Queue); ok {
+		retry.SetLastError(err, errors.Join(tfslices.ApplyToAll(output.ErrorInfo, errorInfoError)...))
+
+		return output, smarterr.NewError(err)
+	}
+
+	return nil, smarterr.NewError(err)
+}
+
+func waitQueueUpdated(ctx context.Context, conn *pcs.Client, clusterID, id string, timeout time.Duration) (*awstypes.Queue, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.QueueStatusUpdating),
+		Target:  enum.Slice(awstypes.QueueStatusActive),
+		Refresh: statusQueue(conn, clusterID, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Queue); ok {
+		retry.SetLastError(err, errors.Join(tfslices.ApplyToAll(output.ErrorInfo, errorInfoError)...))
+
+		return output, smarterr.NewError(err)
+	}
+
+	return nil, smarterr.NewError(err)
+}
+
+func waitQueueDeleted(ctx context.Context, conn *pcs.Client, clusterID, id string, timeout time.Duration) (*awstypes.Queue, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.QueueStatusDeleting),
+		Target:  []string{},
+		Refresh: statusQueue(conn, clusterID, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Queue); ok {
+		retry.SetLastError(err, errors.Join(tfslices.ApplyToAll(output.ErrorInfo, errorInfoError)...))
+
+		return output, smarterr.NewError(err)
+	}
+
+	return nil, smarterr.NewError(err)
+}
+
+type queueResourceModel struct {
+	framework.WithRegionModel
+	ARN                            types.String                                                        `tfsdk:"arn"`
+	ClusterID                      types.String                                                        `tfsdk:"cluster_id"`
+	ComputeNodeGroupConfigurations fwtypes.ListNestedObjectValueOf[computeNodeGroupConfigurationModel] `tfsdk:"compute_node_group_configurations"`
+	ID                             types.String                                                        `tfsdk:"id"`
+	Name                           types.String                                                        `tfsdk:"name"`
+	SlurmConfiguration             fwtypes.ListNestedObjectValueOf[queueSlurmConfigurationModel]       `tfsdk:"slurm_configuration"`
+	Tags                           tftags.Map                                                          `tfsdk:"tags"`
+	TagsAll                        tftags.Map                                                          `tfsdk:"tags_all"`
+	Timeouts                       timeouts.Value                                                      `tfsdk:"timeouts"`
+}
+
+type computeNodeGroupConfigurationModel struct {
+	ComputeNodeGroupID types.String `tfsdk:"compute_node_group_id"`
+}
+
+type queueSlurmConfigurationModel struct {
+	SlurmCustomSettings fwtypes.ListNestedObjectValueOf[slurmCustomSettingModel] `tfsdk:"slurm_custom_settings"`
+}
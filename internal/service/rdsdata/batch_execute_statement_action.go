@@ -0,0 +1,191 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rdsdata
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rdsdata"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rdsdata/types"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_rdsdata_batch_execute_statement, name="Batch Execute Statement")
+func newBatchExecuteStatementAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &batchExecuteStatementAction{}, nil
+}
+
+var (
+	_ action.Action = (*batchExecuteStatementAction)(nil)
+)
+
+type batchExecuteStatementAction struct {
+	framework.ActionWithModel[batchExecuteStatementActionModel]
+}
+
+type batchExecuteStatementActionModel struct {
+	statementActionModel
+	ParameterSets fwtypes.ListNestedObjectValueOf[sqlParameterSetModel] `tfsdk:"parameter_set"`
+}
+
+type sqlParameterSetModel struct {
+	Parameters fwtypes.ListNestedObjectValueOf[sqlParameterModel] `tfsdk:"parameter"`
+}
+
+func (a *batchExecuteStatementAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs a SQL statement once for each parameter set against an Aurora DB cluster using the RDS Data API. The batch can optionally be run in a transaction that is committed when all statements succeed.",
+		Attributes:  statementActionAttributes(),
+		Blocks: map[string]schema.Block{
+			"parameter_set": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[sqlParameterSetModel](ctx),
+				Description: "A set of parameters for one execution of the SQL statement",
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						names.AttrParameter: schema.ListNestedBlock{
+							CustomType:   fwtypes.NewListNestedObjectTypeOf[sqlParameterModel](ctx),
+							Description:  "Parameters for the SQL statement",
+							NestedObject: sqlParameterNestedBlockObject(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *batchExecuteStatementAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config batchExecuteStatementActionModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get AWS client
+	conn := a.Meta().RDSDataClient(ctx)
+
+	resourceARN := fwflex.StringValueFromFramework(ctx, config.ResourceARN)
+	useTransaction := config.Transaction.ValueBool()
+
+	// Set default timeout if not provided
+	timeout := fwactions.TimeoutOr(config.Timeout, 300*time.Second)
+
+	tflog.Info(ctx, "Starting RDS Data batch execute statement action", map[string]any{
+		names.AttrResourceARN: resourceARN,
+		"transaction":         useTransaction,
+		names.AttrTimeout:     timeout.String(),
+	})
+
+	// Send initial progress update
+	cb := fwactions.NewSendProgressFunc(resp)
+
+	parameterSets, diags := expandSQLParameterSets(ctx, config.ParameterSets)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := rdsdata.BatchExecuteStatementInput{
+		Database:      fwflex.StringFromFramework(ctx, config.Database),
+		ParameterSets: parameterSets,
+		ResourceArn:   aws.String(resourceARN),
+		Schema:        fwflex.StringFromFramework(ctx, config.Schema),
+		SecretArn:     fwflex.StringFromFramework(ctx, config.SecretARN),
+		Sql:           fwflex.StringFromFramework(ctx, config.SQL),
+	}
+
+	var transactionID string
+	if useTransaction {
+		cb(ctx, "Beginning transaction on %s...", resourceARN)
+
+		var err error
+		transactionID, err = beginTransaction(ctx, conn, &config.statementActionModel, timeout)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to Begin Transaction",
+				fmt.Sprintf("Could not begin transaction on %s: %s", resourceARN, err),
+			)
+			return
+		}
+
+		input.TransactionId = aws.String(transactionID)
+	}
+
+	cb(ctx, "Executing SQL statement with %d parameter set(s) on %s...", len(parameterSets), resourceARN)
+
+	output, err := retryWhenDatabaseResuming(ctx, timeout, func(ctx context.Context) (*rdsdata.BatchExecuteStatementOutput, error) {
+		return conn.BatchExecuteStatement(ctx, &input)
+	})
+	if err != nil {
+		if transactionID != "" {
+			if _, rollbackErr := rollbackTransaction(ctx, conn, &config.statementActionModel, transactionID); rollbackErr != nil {
+				err = fmt.Errorf("%w; rolling back transaction %s: %w", err, transactionID, rollbackErr)
+			} else {
+				cb(ctx, "Transaction %s rolled back", transactionID)
+			}
+		}
+
+		resp.Diagnostics.AddError(
+			"Failed to Batch Execute Statement",
+			fmt.Sprintf("Could not batch execute SQL statement on %s: %s", resourceARN, err),
+		)
+		return
+	}
+
+	cb(ctx, "SQL statement executed, %d update result(s) returned", len(output.UpdateResults))
+
+	if transactionID != "" {
+		status, err := commitTransaction(ctx, conn, &config.statementActionModel, transactionID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to Commit Transaction",
+				fmt.Sprintf("Could not commit transaction %s on %s: %s", transactionID, resourceARN, err),
+			)
+			return
+		}
+
+		cb(ctx, "Transaction %s committed: %s", transactionID, status)
+	}
+
+	tflog.Info(ctx, "RDS Data batch execute statement action completed successfully", map[string]any{
+		names.AttrResourceARN: resourceARN,
+		"update_results":      len(output.UpdateResults),
+	})
+}
+
+func expandSQLParameterSets(ctx context.Context, v fwtypes.ListNestedObjectValueOf[sqlParameterSetModel]) ([][]awstypes.SqlParameter, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tfList, d := v.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	apiObjects := make([][]awstypes.SqlParameter, 0, len(tfList))
+	for _, tfObject := range tfList {
+		parameters, d := tfObject.Parameters.ToSlice(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		apiObjects = append(apiObjects, expandSQLParameters(ctx, parameters))
+	}
+
+	return apiObjects, diags
+}
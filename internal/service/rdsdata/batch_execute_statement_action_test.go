@@ -0,0 +1,97 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rdsdata_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRDSDataBatchExecuteStatementAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_rds_cluster.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSDataServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBatchExecuteStatementActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableRowCount(ctx, t, resourceName, "tf_acc_test", 3),
+				),
+			},
+		},
+	})
+}
+
+func testAccBatchExecuteStatementActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccStatementActionConfig_base(rName), `
+action "aws_rdsdata_batch_execute_statement" "test" {
+  config {
+    resource_arn = aws_rds_cluster.test.arn
+    secret_arn   = aws_rds_cluster.test.master_user_secret[0].secret_arn
+    database     = aws_rds_cluster.test.database_name
+    sql          = "INSERT INTO tf_acc_test (id, name) VALUES (:id, :name)"
+    transaction  = true
+
+    parameter_set {
+      parameter {
+        name  = "id"
+        value = "1"
+      }
+      parameter {
+        name  = "name"
+        value = "one"
+      }
+    }
+
+    parameter_set {
+      parameter {
+        name  = "id"
+        value = "2"
+      }
+      parameter {
+        name  = "name"
+        value = "two"
+      }
+    }
+
+    parameter_set {
+      parameter {
+        name  = "id"
+        value = "3"
+      }
+      parameter {
+        name = "name"
+      }
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_rdsdata_execute_statement.create_table, action.aws_rdsdata_batch_execute_statement.test]
+    }
+  }
+
+  depends_on = [aws_rds_cluster_instance.test]
+}
+`)
+}
@@ -0,0 +1,154 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rdsdata
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rdsdata"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_rdsdata_execute_statement, name="Execute Statement")
+func newExecuteStatementAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &executeStatementAction{}, nil
+}
+
+var (
+	_ action.Action = (*executeStatementAction)(nil)
+)
+
+type executeStatementAction struct {
+	framework.ActionWithModel[executeStatementActionModel]
+}
+
+type executeStatementActionModel struct {
+	statementActionModel
+	Parameters fwtypes.ListNestedObjectValueOf[sqlParameterModel] `tfsdk:"parameter"`
+}
+
+func (a *executeStatementAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs a SQL statement against an Aurora DB cluster using the RDS Data API. The statement can optionally be run in a transaction that is committed when the statement succeeds.",
+		Attributes:  statementActionAttributes(),
+		Blocks: map[string]schema.Block{
+			names.AttrParameter: schema.ListNestedBlock{
+				CustomType:   fwtypes.NewListNestedObjectTypeOf[sqlParameterModel](ctx),
+				Description:  "Parameters for the SQL statement",
+				NestedObject: sqlParameterNestedBlockObject(),
+			},
+		},
+	}
+}
+
+func (a *executeStatementAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config executeStatementActionModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get AWS client
+	conn := a.Meta().RDSDataClient(ctx)
+
+	resourceARN := fwflex.StringValueFromFramework(ctx, config.ResourceARN)
+	useTransaction := config.Transaction.ValueBool()
+
+	// Set default timeout if not provided
+	timeout := fwactions.TimeoutOr(config.Timeout, 300*time.Second)
+
+	tflog.Info(ctx, "Starting RDS Data execute statement action", map[string]any{
+		names.AttrResourceARN: resourceARN,
+		"transaction":         useTransaction,
+		names.AttrTimeout:     timeout.String(),
+	})
+
+	// Send initial progress update
+	cb := fwactions.NewSendProgressFunc(resp)
+
+	parameters, diags := config.Parameters.ToSlice(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := rdsdata.ExecuteStatementInput{
+		Database:    fwflex.StringFromFramework(ctx, config.Database),
+		Parameters:  expandSQLParameters(ctx, parameters),
+		ResourceArn: aws.String(resourceARN),
+		Schema:      fwflex.StringFromFramework(ctx, config.Schema),
+		SecretArn:   fwflex.StringFromFramework(ctx, config.SecretARN),
+		Sql:         fwflex.StringFromFramework(ctx, config.SQL),
+	}
+
+	var transactionID string
+	if useTransaction {
+		cb(ctx, "Beginning transaction on %s...", resourceARN)
+
+		var err error
+		transactionID, err = beginTransaction(ctx, conn, &config.statementActionModel, timeout)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to Begin Transaction",
+				fmt.Sprintf("Could not begin transaction on %s: %s", resourceARN, err),
+			)
+			return
+		}
+
+		input.TransactionId = aws.String(transactionID)
+	}
+
+	cb(ctx, "Executing SQL statement on %s...", resourceARN)
+
+	output, err := retryWhenDatabaseResuming(ctx, timeout, func(ctx context.Context) (*rdsdata.ExecuteStatementOutput, error) {
+		return conn.ExecuteStatement(ctx, &input)
+	})
+	if err != nil {
+		if transactionID != "" {
+			if _, rollbackErr := rollbackTransaction(ctx, conn, &config.statementActionModel, transactionID); rollbackErr != nil {
+				err = fmt.Errorf("%w; rolling back transaction %s: %w", err, transactionID, rollbackErr)
+			} else {
+				cb(ctx, "Transaction %s rolled back", transactionID)
+			}
+		}
+
+		resp.Diagnostics.AddError(
+			"Failed to Execute Statement",
+			fmt.Sprintf("Could not execute SQL statement on %s: %s", resourceARN, err),
+		)
+		return
+	}
+
+	cb(ctx, "SQL statement executed, %d record(s) updated, %d record(s) returned", output.NumberOfRecordsUpdated, len(output.Records))
+
+	if transactionID != "" {
+		status, err := commitTransaction(ctx, conn, &config.statementActionModel, transactionID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to Commit Transaction",
+				fmt.Sprintf("Could not commit transaction %s on %s: %s", transactionID, resourceARN, err),
+			)
+			return
+		}
+
+		cb(ctx, "Transaction %s committed: %s", transactionID, status)
+	}
+
+	tflog.Info(ctx, "RDS Data execute statement action completed successfully", map[string]any{
+		names.AttrResourceARN:       resourceARN,
+		"number_of_records_updated": output.NumberOfRecordsUpdated,
+	})
+}
@@ -0,0 +1,267 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rdsdata_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rdsdata"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rdsdata/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRDSDataExecuteStatementAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_rds_cluster.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSDataServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExecuteStatementActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableRowCount(ctx, t, resourceName, "tf_acc_test", 1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSDataExecuteStatementAction_transaction(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_rds_cluster.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSDataServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExecuteStatementActionConfig_transaction(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableRowCount(ctx, t, resourceName, "tf_acc_test", 1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSDataExecuteStatementAction_invalidSQL(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSDataServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccExecuteStatementActionConfig_invalidSQL(rName),
+				ExpectError: regexache.MustCompile(`Failed to Execute Statement`),
+			},
+		},
+	})
+}
+
+func testAccCheckTableRowCount(ctx context.Context, t *testing.T, n, table string, want int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).RDSDataClient(ctx)
+
+		input := rdsdata.ExecuteStatementInput{
+			Database:    aws.String(rs.Primary.Attributes[names.AttrDatabaseName]),
+			ResourceArn: aws.String(rs.Primary.Attributes[names.AttrARN]),
+			SecretArn:   aws.String(rs.Primary.Attributes["master_user_secret.0.secret_arn"]),
+			Sql:         aws.String(fmt.Sprintf("SELECT COUNT(*) FROM %s", table)),
+		}
+
+		output, err := conn.ExecuteStatement(ctx, &input)
+
+		if err != nil {
+			return err
+		}
+
+		if len(output.Records) != 1 || len(output.Records[0]) != 1 {
+			return fmt.Errorf("Unexpected result counting rows in %s: %v", table, output.Records)
+		}
+
+		v, ok := output.Records[0][0].(*awstypes.FieldMemberLongValue)
+		if !ok {
+			return fmt.Errorf("Unexpected field type counting rows in %s: %T", table, output.Records[0][0])
+		}
+
+		if got := v.Value; got != want {
+			return fmt.Errorf("Expected %d rows in %s, got %d", want, table, got)
+		}
+
+		return nil
+	}
+}
+
+func testAccStatementActionConfig_base(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
+data "aws_rds_orderable_db_instance" "test" {
+  engine                     = "aurora-postgresql"
+  engine_latest_version      = true
+  preferred_instance_classes = ["db.serverless"]
+}
+
+resource "aws_db_subnet_group" "test" {
+  name       = %[1]q
+  subnet_ids = aws_subnet.test[*].id
+}
+
+resource "aws_rds_cluster" "test" {
+  cluster_identifier          = %[1]q
+  master_username             = "tfacctest"
+  manage_master_user_password = true
+  database_name               = "test"
+  skip_final_snapshot         = true
+  engine                      = data.aws_rds_orderable_db_instance.test.engine
+  engine_version              = data.aws_rds_orderable_db_instance.test.engine_version
+  enable_http_endpoint        = true
+  db_subnet_group_name        = aws_db_subnet_group.test.name
+
+  serverlessv2_scaling_configuration {
+    max_capacity = 1.0
+    min_capacity = 0.5
+  }
+}
+
+resource "aws_rds_cluster_instance" "test" {
+  cluster_identifier = aws_rds_cluster.test.id
+  instance_class     = "db.serverless"
+  engine             = aws_rds_cluster.test.engine
+  engine_version     = aws_rds_cluster.test.engine_version
+}
+
+action "aws_rdsdata_execute_statement" "create_table" {
+  config {
+    resource_arn = aws_rds_cluster.test.arn
+    secret_arn   = aws_rds_cluster.test.master_user_secret[0].secret_arn
+    database     = aws_rds_cluster.test.database_name
+    sql          = "CREATE TABLE IF NOT EXISTS tf_acc_test (id VARCHAR(64) PRIMARY KEY, name VARCHAR(64))"
+  }
+}
+`, rName))
+}
+
+func testAccExecuteStatementActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccStatementActionConfig_base(rName), `
+action "aws_rdsdata_execute_statement" "test" {
+  config {
+    resource_arn = aws_rds_cluster.test.arn
+    secret_arn   = aws_rds_cluster.test.master_user_secret[0].secret_arn
+    database     = aws_rds_cluster.test.database_name
+    sql          = "INSERT INTO tf_acc_test (id, name) VALUES (:id, :name)"
+
+    parameter {
+      name  = "id"
+      value = "1"
+    }
+
+    parameter {
+      name = "name"
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_rdsdata_execute_statement.create_table, action.aws_rdsdata_execute_statement.test]
+    }
+  }
+
+  depends_on = [aws_rds_cluster_instance.test]
+}
+`)
+}
+
+func testAccExecuteStatementActionConfig_transaction(rName string) string {
+	return acctest.ConfigCompose(testAccStatementActionConfig_base(rName), `
+action "aws_rdsdata_execute_statement" "test" {
+  config {
+    resource_arn = aws_rds_cluster.test.arn
+    secret_arn   = aws_rds_cluster.test.master_user_secret[0].secret_arn
+    database     = aws_rds_cluster.test.database_name
+    sql          = "INSERT INTO tf_acc_test (id, name) VALUES ('1', 'test')"
+    transaction  = true
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_rdsdata_execute_statement.create_table, action.aws_rdsdata_execute_statement.test]
+    }
+  }
+
+  depends_on = [aws_rds_cluster_instance.test]
+}
+`)
+}
+
+func testAccExecuteStatementActionConfig_invalidSQL(rName string) string {
+	return acctest.ConfigCompose(testAccStatementActionConfig_base(rName), `
+action "aws_rdsdata_execute_statement" "test" {
+  config {
+    resource_arn = aws_rds_cluster.test.arn
+    secret_arn   = aws_rds_cluster.test.master_user_secret[0].secret_arn
+    database     = aws_rds_cluster.test.database_name
+    sql          = "SELECT * FROM tf_acc_test_does_not_exist"
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_rdsdata_execute_statement.test]
+    }
+  }
+
+  depends_on = [aws_rds_cluster_instance.test]
+}
+`)
+}
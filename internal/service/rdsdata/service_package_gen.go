@@ -19,6 +19,23 @@ import (
 
 type servicePackage struct{}
 
+func (p *servicePackage) Actions(ctx context.Context) []*inttypes.ServicePackageAction {
+	return []*inttypes.ServicePackageAction{
+		{
+			Factory:  newBatchExecuteStatementAction,
+			TypeName: "aws_rdsdata_batch_execute_statement",
+			Name:     "Batch Execute Statement",
+			Region:   inttypes.ResourceRegionDefault(),
+		},
+		{
+			Factory:  newExecuteStatementAction,
+			TypeName: "aws_rdsdata_execute_statement",
+			Name:     "Execute Statement",
+			Region:   inttypes.ResourceRegionDefault(),
+		},
+	}
+}
+
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.ServicePackageFrameworkDataSource {
 	return []*inttypes.ServicePackageFrameworkDataSource{}
 }
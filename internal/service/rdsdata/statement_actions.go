@@ -0,0 +1,187 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rdsdata
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rdsdata"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rdsdata/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/backoff"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// statementActionModel contains the configuration shared by the statement actions.
+type statementActionModel struct {
+	framework.WithRegionModel
+	Database    types.String `tfsdk:"database"`
+	ResourceARN fwtypes.ARN  `tfsdk:"resource_arn"`
+	Schema      types.String `tfsdk:"schema"`
+	SecretARN   fwtypes.ARN  `tfsdk:"secret_arn"`
+	SQL         types.String `tfsdk:"sql"`
+	Timeout     types.Int64  `tfsdk:"timeout"`
+	Transaction types.Bool   `tfsdk:"transaction"`
+}
+
+type sqlParameterModel struct {
+	Name     types.String                          `tfsdk:"name"`
+	TypeHint fwtypes.StringEnum[awstypes.TypeHint] `tfsdk:"type_hint"`
+	Value    types.String                          `tfsdk:"value"`
+}
+
+func statementActionAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		names.AttrDatabase: schema.StringAttribute{
+			Description: "The name of the database",
+			Optional:    true,
+		},
+		names.AttrResourceARN: schema.StringAttribute{
+			CustomType:  fwtypes.ARNType,
+			Description: "The ARN of the Aurora DB cluster",
+			Required:    true,
+		},
+		names.AttrSchema: schema.StringAttribute{
+			Description: "The name of the database schema",
+			Optional:    true,
+		},
+		"secret_arn": schema.StringAttribute{
+			CustomType:  fwtypes.ARNType,
+			Description: "The ARN of the Secrets Manager secret that enables access to the DB cluster",
+			Required:    true,
+		},
+		"sql": schema.StringAttribute{
+			Description: "The SQL statement to run",
+			Required:    true,
+		},
+		names.AttrTimeout: schema.Int64Attribute{
+			Description: "Timeout in seconds to wait for the DB cluster to become available, for example while an Aurora Serverless cluster resumes (default: 300)",
+			Optional:    true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(30),
+				int64validator.AtMost(3600),
+			},
+		},
+		"transaction": schema.BoolAttribute{
+			Description: "Whether to run the SQL in a transaction that is committed on success and rolled back on failure",
+			Optional:    true,
+		},
+	}
+}
+
+func sqlParameterNestedBlockObject() schema.NestedBlockObject {
+	return schema.NestedBlockObject{
+		Attributes: map[string]schema.Attribute{
+			names.AttrName: schema.StringAttribute{
+				Description: "The name of the parameter, referenced in the SQL statement as :name",
+				Required:    true,
+			},
+			"type_hint": schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.TypeHint](),
+				Description: "A hint that specifies the correct object type for the value",
+				Optional:    true,
+			},
+			names.AttrValue: schema.StringAttribute{
+				Description: "The value of the parameter. Omit to pass NULL",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func expandSQLParameters(ctx context.Context, tfList []*sqlParameterModel) []awstypes.SqlParameter {
+	return tfslices.ApplyToAll(tfList, func(v *sqlParameterModel) awstypes.SqlParameter {
+		apiObject := awstypes.SqlParameter{
+			Name:     fwflex.StringFromFramework(ctx, v.Name),
+			TypeHint: v.TypeHint.ValueEnum(),
+		}
+
+		if v.Value.IsNull() {
+			apiObject.Value = &awstypes.FieldMemberIsNull{Value: true}
+		} else {
+			apiObject.Value = &awstypes.FieldMemberStringValue{Value: v.Value.ValueString()}
+		}
+
+		return apiObject
+	})
+}
+
+// retryWhenDatabaseResuming retries f while the DB cluster is resuming.
+// DatabaseResumingException is returned before the statement reaches the database, so retrying cannot run it twice.
+func retryWhenDatabaseResuming[T any](ctx context.Context, timeout time.Duration, f func(context.Context) (T, error)) (T, error) {
+	var output T
+	var err error
+	for l := backoff.NewLoop(timeout); l.Continue(ctx); {
+		output, err = f(ctx)
+
+		if errs.IsA[*awstypes.DatabaseResumingException](err) {
+			continue
+		}
+
+		break
+	}
+
+	return output, err
+}
+
+func beginTransaction(ctx context.Context, conn *rdsdata.Client, config *statementActionModel, timeout time.Duration) (string, error) {
+	input := rdsdata.BeginTransactionInput{
+		Database:    fwflex.StringFromFramework(ctx, config.Database),
+		ResourceArn: fwflex.StringFromFramework(ctx, config.ResourceARN),
+		Schema:      fwflex.StringFromFramework(ctx, config.Schema),
+		SecretArn:   fwflex.StringFromFramework(ctx, config.SecretARN),
+	}
+
+	output, err := retryWhenDatabaseResuming(ctx, timeout, func(ctx context.Context) (*rdsdata.BeginTransactionOutput, error) {
+		return conn.BeginTransaction(ctx, &input)
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return aws.ToString(output.TransactionId), nil
+}
+
+func commitTransaction(ctx context.Context, conn *rdsdata.Client, config *statementActionModel, transactionID string) (string, error) {
+	input := rdsdata.CommitTransactionInput{
+		ResourceArn:   fwflex.StringFromFramework(ctx, config.ResourceARN),
+		SecretArn:     fwflex.StringFromFramework(ctx, config.SecretARN),
+		TransactionId: aws.String(transactionID),
+	}
+
+	output, err := conn.CommitTransaction(ctx, &input)
+
+	if err != nil {
+		return "", err
+	}
+
+	return aws.ToString(output.TransactionStatus), nil
+}
+
+func rollbackTransaction(ctx context.Context, conn *rdsdata.Client, config *statementActionModel, transactionID string) (string, error) {
+	input := rdsdata.RollbackTransactionInput{
+		ResourceArn:   fwflex.StringFromFramework(ctx, config.ResourceARN),
+		SecretArn:     fwflex.StringFromFramework(ctx, config.SecretARN),
+		TransactionId: aws.String(transactionID),
+	}
+
+	output, err := conn.RollbackTransaction(ctx, &input)
+
+	if err != nil {
+		return "", err
+	}
+
+	return aws.ToString(output.TransactionStatus), nil
+}
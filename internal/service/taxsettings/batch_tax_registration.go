@@ -0,0 +1,262 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package taxsettings
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/taxsettings"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/taxsettings/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_taxsettings_batch_tax_registration", name="Batch Tax Registration")
+// @Region(global=true)
+// @NoImport
+func newBatchTaxRegistrationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &batchTaxRegistrationResource{}, nil
+}
+
+type batchTaxRegistrationResource struct {
+	framework.ResourceWithModel[batchTaxRegistrationResourceModel]
+}
+
+func (r *batchTaxRegistrationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	attributes := taxRegistrationEntrySchemaAttributes()
+	attributes["account_ids"] = schema.SetAttribute{
+		CustomType:  fwtypes.SetOfStringType,
+		ElementType: types.StringType,
+		Required:    true,
+		Validators: []validator.Set{
+			setvalidator.SizeAtLeast(1),
+			setvalidator.ValueStringsAre(fwvalidators.AWSAccountID()),
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: attributes,
+		Blocks:     taxRegistrationEntrySchemaBlocks(ctx),
+	}
+}
+
+func (r *batchTaxRegistrationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan batchTaxRegistrationResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TaxSettingsClient(ctx)
+
+	var entry awstypes.TaxRegistrationEntry
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, plan.taxRegistrationModel, &entry))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	accountIDs := fwflex.ExpandFrameworkStringValueSet(ctx, plan.AccountIDs)
+	output, err := batchPutTaxRegistration(ctx, conn, accountIDs, &entry)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err)
+		return
+	}
+
+	plan.Status = fwtypes.StringEnumValue(output.Status)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, plan))
+}
+
+func (r *batchTaxRegistrationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data batchTaxRegistrationResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TaxSettingsClient(ctx)
+
+	// An account is dropped from state when its registration has been removed or no longer
+	// matches the configured registration, so that the next apply puts it again.
+	var accountIDs []string
+	for _, accountID := range fwflex.ExpandFrameworkStringValueSet(ctx, data.AccountIDs) {
+		output, err := findTaxRegistrationByAccountID(ctx, conn, accountID)
+
+		if retry.NotFound(err) {
+			tflog.Warn(ctx, "Tax Registration not found, removing account", map[string]any{
+				names.AttrAccountID: accountID,
+			})
+			continue
+		}
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, accountID)
+			return
+		}
+
+		var registration taxRegistrationModel
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &registration))
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		diff, d := fwflex.Diff(ctx, data.taxRegistrationModel, registration, fwflex.WithIgnoredField("Status"))
+		smerr.AddEnrich(ctx, &response.Diagnostics, d)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if diff.HasChanges() {
+			tflog.Warn(ctx, "Tax Registration differs from configuration, removing account", map[string]any{
+				names.AttrAccountID: accountID,
+			})
+			continue
+		}
+
+		accountIDs = append(accountIDs, accountID)
+	}
+
+	if len(accountIDs) == 0 {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(errors.New("no tax registrations found")))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	data.AccountIDs = fwflex.FlattenFrameworkStringValueSetOfString(ctx, accountIDs)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *batchTaxRegistrationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old batchTaxRegistrationResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &new))
+	if response.Diagnostics.HasError() {
+		return
+	}
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &old))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TaxSettingsClient(ctx)
+
+	newAccountIDs, oldAccountIDs := fwflex.ExpandFrameworkStringValueSet(ctx, new.AccountIDs), fwflex.ExpandFrameworkStringValueSet(ctx, old.AccountIDs)
+	if del := oldAccountIDs.Difference(newAccountIDs); len(del) > 0 {
+		if err := batchDeleteTaxRegistration(ctx, conn, del); err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err)
+			return
+		}
+	}
+
+	diff, d := fwflex.Diff(ctx, new, old, fwflex.WithIgnoredField("AccountIDs"), fwflex.WithIgnoredField("Status"))
+	smerr.AddEnrich(ctx, &response.Diagnostics, d)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Only accounts new to the batch need to be put unless the registration itself has changed.
+	accountIDs := newAccountIDs.Difference(oldAccountIDs)
+	if diff.HasChanges() {
+		accountIDs = newAccountIDs
+	}
+
+	if len(accountIDs) > 0 {
+		var entry awstypes.TaxRegistrationEntry
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, new.taxRegistrationModel, &entry))
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		output, err := batchPutTaxRegistration(ctx, conn, accountIDs, &entry)
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err)
+			return
+		}
+
+		new.Status = fwtypes.StringEnumValue(output.Status)
+	} else {
+		new.Status = old.Status
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &new))
+}
+
+func (r *batchTaxRegistrationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data batchTaxRegistrationResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TaxSettingsClient(ctx)
+
+	if err := batchDeleteTaxRegistration(ctx, conn, fwflex.ExpandFrameworkStringValueSet(ctx, data.AccountIDs)); err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err)
+		return
+	}
+}
+
+func batchPutTaxRegistration(ctx context.Context, conn *taxsettings.Client, accountIDs []string, entry *awstypes.TaxRegistrationEntry) (*taxsettings.BatchPutTaxRegistrationOutput, error) {
+	input := taxsettings.BatchPutTaxRegistrationInput{
+		AccountIds:           accountIDs,
+		TaxRegistrationEntry: entry,
+	}
+	output, err := conn.BatchPutTaxRegistration(ctx, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var errs []error
+	for _, v := range output.Errors {
+		errs = append(errs, fmt.Errorf("putting Tax Registration (%s): %s: %s", aws.ToString(v.AccountId), aws.ToString(v.Code), aws.ToString(v.Message)))
+	}
+
+	return output, errors.Join(errs...)
+}
+
+func batchDeleteTaxRegistration(ctx context.Context, conn *taxsettings.Client, accountIDs []string) error {
+	input := taxsettings.BatchDeleteTaxRegistrationInput{
+		AccountIds: accountIDs,
+	}
+	output, err := conn.BatchDeleteTaxRegistration(ctx, &input)
+
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, v := range output.Errors {
+		// Registrations that are already gone are not an error.
+		if aws.ToString(v.Code) == "ResourceNotFoundException" {
+			continue
+		}
+
+		errs = append(errs, fmt.Errorf("deleting Tax Registration (%s): %s: %s", aws.ToString(v.AccountId), aws.ToString(v.Code), aws.ToString(v.Message)))
+	}
+
+	return errors.Join(errs...)
+}
+
+type batchTaxRegistrationResourceModel struct {
+	AccountIDs fwtypes.SetOfString `tfsdk:"account_ids"`
+	taxRegistrationModel
+}
@@ -0,0 +1,224 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package taxsettings_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/taxsettings"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/taxsettings/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tftaxsettings "github.com/hashicorp/terraform-provider-aws/internal/service/taxsettings"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccTaxSettingsBatchTaxRegistration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	registrationID := acctest.SkipIfEnvVarNotSet(t, envVarRegistrationID)
+	countryCode := acctest.SkipIfEnvVarNotSet(t, envVarCountryCode)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_taxsettings_batch_tax_registration.test"
+
+	acctest.Test(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.TaxSettingsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBatchTaxRegistrationDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBatchTaxRegistrationConfig_basic(rName, registrationID, countryCode),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBatchTaxRegistrationExists(ctx, t, resourceName),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("account_ids"), knownvalue.SetSizeExact(1)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("legal_name"), knownvalue.StringExact(rName)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("registration_id"), knownvalue.StringExact(registrationID)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrStatus), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccTaxSettingsBatchTaxRegistration_drift(t *testing.T) {
+	ctx := acctest.Context(t)
+	registrationID := acctest.SkipIfEnvVarNotSet(t, envVarRegistrationID)
+	countryCode := acctest.SkipIfEnvVarNotSet(t, envVarCountryCode)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	rNameUpdated := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_taxsettings_batch_tax_registration.test"
+
+	acctest.Test(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.TaxSettingsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBatchTaxRegistrationDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBatchTaxRegistrationConfig_basic(rName, registrationID, countryCode),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBatchTaxRegistrationExists(ctx, t, resourceName),
+					testAccCheckBatchTaxRegistrationPutLegalName(ctx, t, resourceName, rNameUpdated),
+				),
+				ExpectNonEmptyPlan: true,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+			{
+				Config: testAccBatchTaxRegistrationConfig_basic(rName, registrationID, countryCode),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("account_ids"), knownvalue.SetSizeExact(1)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("legal_name"), knownvalue.StringExact(rName)),
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckBatchTaxRegistrationDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).TaxSettingsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_taxsettings_batch_tax_registration" {
+				continue
+			}
+
+			for _, accountID := range testAccBatchTaxRegistrationAccountIDs(rs) {
+				_, err := tftaxsettings.FindTaxRegistrationByAccountID(ctx, conn, accountID)
+
+				if retry.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				return fmt.Errorf("Tax Settings Tax Registration %s still exists", accountID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBatchTaxRegistrationExists(ctx context.Context, t *testing.T, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).TaxSettingsClient(ctx)
+
+		for _, accountID := range testAccBatchTaxRegistrationAccountIDs(rs) {
+			if _, err := tftaxsettings.FindTaxRegistrationByAccountID(ctx, conn, accountID); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckBatchTaxRegistrationPutLegalName changes the legal name of each account's registration out of band.
+func testAccCheckBatchTaxRegistrationPutLegalName(ctx context.Context, t *testing.T, n, legalName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).TaxSettingsClient(ctx)
+
+		for _, accountID := range testAccBatchTaxRegistrationAccountIDs(rs) {
+			output, err := tftaxsettings.FindTaxRegistrationByAccountID(ctx, conn, accountID)
+
+			if err != nil {
+				return err
+			}
+
+			input := taxsettings.PutTaxRegistrationInput{
+				AccountId: aws.String(accountID),
+				TaxRegistrationEntry: &awstypes.TaxRegistrationEntry{
+					CertifiedEmailId: output.CertifiedEmailId,
+					LegalAddress:     output.LegalAddress,
+					LegalName:        aws.String(legalName),
+					RegistrationId:   output.RegistrationId,
+					RegistrationType: output.RegistrationType,
+					Sector:           output.Sector,
+				},
+			}
+
+			if _, err := conn.PutTaxRegistration(ctx, &input); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccBatchTaxRegistrationAccountIDs(rs *terraform.ResourceState) []string {
+	var accountIDs []string
+	for k, v := range rs.Primary.Attributes {
+		if strings.HasPrefix(k, "account_ids.") && k != "account_ids.#" {
+			accountIDs = append(accountIDs, v)
+		}
+	}
+
+	return accountIDs
+}
+
+func testAccBatchTaxRegistrationConfig_basic(rName, registrationID, countryCode string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_taxsettings_batch_tax_registration" "test" {
+  account_ids       = [data.aws_caller_identity.current.account_id]
+  legal_name        = %[1]q
+  registration_id   = %[2]q
+  registration_type = "VAT"
+
+  legal_address {
+    address_line_1 = "1 Main Street"
+    city           = "Anytown"
+    country_code   = %[3]q
+    postal_code    = "12345"
+  }
+}
+`, rName, registrationID, countryCode)
+}
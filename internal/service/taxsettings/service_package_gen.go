@@ -20,11 +20,35 @@ import (
 type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.ServicePackageFrameworkDataSource {
-	return []*inttypes.ServicePackageFrameworkDataSource{}
+	return []*inttypes.ServicePackageFrameworkDataSource{
+		{
+			Factory:  newTaxRegistrationDataSource,
+			TypeName: "aws_taxsettings_tax_registration",
+			Name:     "Tax Registration",
+			Region:   inttypes.ResourceRegionDisabled(),
+		},
+	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.ServicePackageFrameworkResource {
-	return []*inttypes.ServicePackageFrameworkResource{}
+	return []*inttypes.ServicePackageFrameworkResource{
+		{
+			Factory:  newBatchTaxRegistrationResource,
+			TypeName: "aws_taxsettings_batch_tax_registration",
+			Name:     "Batch Tax Registration",
+			Region:   inttypes.ResourceRegionDisabled(),
+		},
+		{
+			Factory:  newTaxRegistrationResource,
+			TypeName: "aws_taxsettings_tax_registration",
+			Name:     "Tax Registration",
+			Region:   inttypes.ResourceRegionDisabled(),
+			Identity: inttypes.GlobalSingleParameterIdentityWithMappedName("registration_account_id", names.AttrAccountID),
+			Import: inttypes.FrameworkImport{
+				WrappedImport: true,
+			},
+		},
+	}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*inttypes.ServicePackageSDKDataSource {
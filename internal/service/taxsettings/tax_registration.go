@@ -0,0 +1,337 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package taxsettings
+
+import (
+	"context"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/taxsettings"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/taxsettings/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_taxsettings_tax_registration", name="Tax Registration")
+// @Region(global=true)
+// @IdentityAttribute("registration_account_id", resourceAttributeName="account_id")
+// @Testing(hasNoPreExistingResource=true)
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/taxsettings/types;awstypes;awstypes.TaxRegistration")
+// @Testing(serialize=true)
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(requireEnvVarValue="TAXSETTINGS_VAT_REGISTRATION_ID")
+// @Testing(requireEnvVarValue="TAXSETTINGS_VAT_COUNTRY_CODE")
+// @Testing(importStateIdAttribute="account_id")
+func newTaxRegistrationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &taxRegistrationResource{}, nil
+}
+
+type taxRegistrationResource struct {
+	framework.ResourceWithModel[taxRegistrationResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *taxRegistrationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	attributes := taxRegistrationEntrySchemaAttributes()
+	attributes[names.AttrAccountID] = schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			fwvalidators.AWSAccountID(),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: attributes,
+		Blocks:     taxRegistrationEntrySchemaBlocks(ctx),
+	}
+}
+
+func (r *taxRegistrationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan taxRegistrationResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TaxSettingsClient(ctx)
+
+	accountID := fwflex.StringValueFromFramework(ctx, plan.AccountID)
+	if accountID == "" {
+		accountID = r.Meta().AccountID(ctx)
+	}
+
+	var entry awstypes.TaxRegistrationEntry
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, plan.taxRegistrationModel, &entry))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := taxsettings.PutTaxRegistrationInput{
+		AccountId:            aws.String(accountID),
+		TaxRegistrationEntry: &entry,
+	}
+	_, err := conn.PutTaxRegistration(ctx, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, accountID)
+		return
+	}
+
+	output, err := findTaxRegistrationByAccountID(ctx, conn, accountID)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, accountID)
+		return
+	}
+
+	// Set values for unknowns.
+	plan.AccountID = fwflex.StringValueToFramework(ctx, accountID)
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &plan.taxRegistrationModel))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, plan))
+}
+
+func (r *taxRegistrationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data taxRegistrationResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TaxSettingsClient(ctx)
+
+	accountID := fwflex.StringValueFromFramework(ctx, data.AccountID)
+	output, err := findTaxRegistrationByAccountID(ctx, conn, accountID)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, accountID)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &data.taxRegistrationModel))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *taxRegistrationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan taxRegistrationResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TaxSettingsClient(ctx)
+
+	accountID := fwflex.StringValueFromFramework(ctx, plan.AccountID)
+	var entry awstypes.TaxRegistrationEntry
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, plan.taxRegistrationModel, &entry))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := taxsettings.PutTaxRegistrationInput{
+		AccountId:            aws.String(accountID),
+		TaxRegistrationEntry: &entry,
+	}
+	_, err := conn.PutTaxRegistration(ctx, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, accountID)
+		return
+	}
+
+	output, err := findTaxRegistrationByAccountID(ctx, conn, accountID)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, accountID)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &plan.taxRegistrationModel))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &plan))
+}
+
+func (r *taxRegistrationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data taxRegistrationResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TaxSettingsClient(ctx)
+
+	accountID := fwflex.StringValueFromFramework(ctx, data.AccountID)
+	input := taxsettings.DeleteTaxRegistrationInput{
+		AccountId: aws.String(accountID),
+	}
+	_, err := conn.DeleteTaxRegistration(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, accountID)
+		return
+	}
+}
+
+func findTaxRegistrationByAccountID(ctx context.Context, conn *taxsettings.Client, accountID string) (*awstypes.TaxRegistration, error) {
+	input := taxsettings.GetTaxRegistrationInput{
+		AccountId: aws.String(accountID),
+	}
+	output, err := conn.GetTaxRegistration(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil || output.TaxRegistration == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError())
+	}
+
+	if status := output.TaxRegistration.Status; status == awstypes.TaxRegistrationStatusDeleted {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			Message: string(status),
+		})
+	}
+
+	return output.TaxRegistration, nil
+}
+
+// taxRegistrationEntrySchemaAttributes returns the schema attributes shared by the tax registration resources.
+func taxRegistrationEntrySchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"certified_email_id": schema.StringAttribute{
+			Optional: true,
+		},
+		"legal_name": schema.StringAttribute{
+			Optional: true,
+		},
+		"registration_id": schema.StringAttribute{
+			Required: true,
+		},
+		"registration_type": schema.StringAttribute{
+			CustomType: fwtypes.StringEnumType[awstypes.TaxRegistrationType](),
+			Required:   true,
+		},
+		"sector": schema.StringAttribute{
+			CustomType: fwtypes.StringEnumType[awstypes.Sector](),
+			Optional:   true,
+		},
+		names.AttrStatus: schema.StringAttribute{
+			CustomType: fwtypes.StringEnumType[awstypes.TaxRegistrationStatus](),
+			Computed:   true,
+		},
+	}
+}
+
+// taxRegistrationEntrySchemaBlocks returns the schema blocks shared by the tax registration resources.
+func taxRegistrationEntrySchemaBlocks(ctx context.Context) map[string]schema.Block {
+	return map[string]schema.Block{
+		"legal_address": schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[addressModel](ctx),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"address_line_1": schema.StringAttribute{
+						Required: true,
+					},
+					"address_line_2": schema.StringAttribute{
+						Optional: true,
+					},
+					"address_line_3": schema.StringAttribute{
+						Optional: true,
+					},
+					"city": schema.StringAttribute{
+						Required: true,
+					},
+					"country_code": schema.StringAttribute{
+						Required: true,
+					},
+					"district_or_county": schema.StringAttribute{
+						Optional: true,
+					},
+					"postal_code": schema.StringAttribute{
+						Required: true,
+					},
+					"state_or_region": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+type taxRegistrationResourceModel struct {
+	AccountID types.String `tfsdk:"account_id"`
+	taxRegistrationModel
+}
+
+type taxRegistrationModel struct {
+	CertifiedEmailID types.String                                       `tfsdk:"certified_email_id"`
+	LegalAddress     fwtypes.ListNestedObjectValueOf[addressModel]      `tfsdk:"legal_address"`
+	LegalName        types.String                                       `tfsdk:"legal_name"`
+	RegistrationID   types.String                                       `tfsdk:"registration_id"`
+	RegistrationType fwtypes.StringEnum[awstypes.TaxRegistrationType]   `tfsdk:"registration_type"`
+	Sector           fwtypes.StringEnum[awstypes.Sector]                `tfsdk:"sector"`
+	Status           fwtypes.StringEnum[awstypes.TaxRegistrationStatus] `tfsdk:"status"`
+}
+
+type addressModel struct {
+	AddressLine1     types.String `tfsdk:"address_line_1"`
+	AddressLine2     types.String `tfsdk:"address_line_2"`
+	AddressLine3     types.String `tfsdk:"address_line_3"`
+	City             types.String `tfsdk:"city"`
+	CountryCode      types.String `tfsdk:"country_code"`
+	DistrictOrCounty types.String `tfsdk:"district_or_county"`
+	PostalCode       types.String `tfsdk:"postal_code"`
+	StateOrRegion    types.String `tfsdk:"state_or_region"`
+}
@@ -0,0 +1,101 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package taxsettings
+
+import (
+	"context"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/taxsettings/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_taxsettings_tax_registration", name="Tax Registration")
+// @Region(global=true)
+func newTaxRegistrationDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &taxRegistrationDataSource{}, nil
+}
+
+type taxRegistrationDataSource struct {
+	framework.DataSourceWithModel[taxRegistrationDataSourceModel]
+}
+
+func (d *taxRegistrationDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrAccountID: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					fwvalidators.AWSAccountID(),
+				},
+			},
+			"certified_email_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"legal_address": framework.DataSourceComputedListOfObjectAttribute[addressModel](ctx),
+			"legal_name": schema.StringAttribute{
+				Computed: true,
+			},
+			"registration_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"registration_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.TaxRegistrationType](),
+				Computed:   true,
+			},
+			"sector": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.Sector](),
+				Computed:   true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.TaxRegistrationStatus](),
+				Computed:   true,
+			},
+		},
+	}
+}
+
+func (d *taxRegistrationDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data taxRegistrationDataSourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().TaxSettingsClient(ctx)
+
+	accountID := fwflex.StringValueFromFramework(ctx, data.AccountID)
+	if accountID == "" {
+		accountID = d.Meta().AccountID(ctx)
+	}
+
+	output, err := findTaxRegistrationByAccountID(ctx, conn, accountID)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, accountID)
+		return
+	}
+
+	data.AccountID = fwflex.StringValueToFramework(ctx, accountID)
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &data.taxRegistrationModel))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+type taxRegistrationDataSourceModel struct {
+	AccountID types.String `tfsdk:"account_id"`
+	taxRegistrationModel
+}
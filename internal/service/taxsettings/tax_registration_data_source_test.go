@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package taxsettings_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccTaxSettingsTaxRegistrationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	registrationID := acctest.SkipIfEnvVarNotSet(t, envVarRegistrationID)
+	countryCode := acctest.SkipIfEnvVarNotSet(t, envVarCountryCode)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_taxsettings_tax_registration.test"
+	dataSourceName := "data.aws_taxsettings_tax_registration.test"
+
+	acctest.Test(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.TaxSettingsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaxRegistrationDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaxRegistrationDataSourceConfig_basic(rName, registrationID, countryCode),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrAccountID, resourceName, names.AttrAccountID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "certified_email_id", resourceName, "certified_email_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "legal_address.#", resourceName, "legal_address.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "legal_address.0.address_line_1", resourceName, "legal_address.0.address_line_1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "legal_address.0.city", resourceName, "legal_address.0.city"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "legal_address.0.country_code", resourceName, "legal_address.0.country_code"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "legal_address.0.postal_code", resourceName, "legal_address.0.postal_code"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "legal_name", resourceName, "legal_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "registration_id", resourceName, "registration_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "registration_type", resourceName, "registration_type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "sector", resourceName, "sector"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrStatus, resourceName, names.AttrStatus),
+				),
+			},
+		},
+	})
+}
+
+func testAccTaxRegistrationDataSourceConfig_basic(rName, registrationID, countryCode string) string {
+	return acctest.ConfigCompose(testAccTaxRegistrationConfig_basic(rName, registrationID, countryCode), `
+data "aws_taxsettings_tax_registration" "test" {
+  account_id = aws_taxsettings_tax_registration.test.account_id
+}
+`)
+}
@@ -0,0 +1,129 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/identitytests/main.go; DO NOT EDIT.
+
+package taxsettings_test
+
+import (
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/taxsettings/types"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfknownvalue "github.com/hashicorp/terraform-provider-aws/internal/acctest/knownvalue"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccTaxSettingsTaxRegistration_identitySerial(t *testing.T) {
+	t.Helper()
+
+	testCases := map[string]func(t *testing.T){
+		acctest.CtBasic: testAccTaxSettingsTaxRegistration_Identity_basic,
+	}
+
+	acctest.RunSerialTests1Level(t, testCases, 0)
+}
+
+func testAccTaxSettingsTaxRegistration_Identity_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var v awstypes.TaxRegistration
+	acctest.SkipIfEnvVarNotSet(t, "TAXSETTINGS_VAT_REGISTRATION_ID")
+	acctest.SkipIfEnvVarNotSet(t, "TAXSETTINGS_VAT_COUNTRY_CODE")
+	resourceName := "aws_taxsettings_tax_registration.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.Test(ctx, t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.TaxSettingsServiceID),
+		CheckDestroy:             testAccCheckTaxRegistrationDestroy(ctx, t),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			// Step 1: Setup
+			{
+				ConfigDirectory: config.StaticDirectory("testdata/TaxRegistration/basic/"),
+				ConfigVariables: config.Variables{
+					acctest.CtRName:                   config.StringVariable(rName),
+					"TAXSETTINGS_VAT_REGISTRATION_ID": config.StringVariable(acctest.SkipIfEnvVarNotSet(t, "TAXSETTINGS_VAT_REGISTRATION_ID")),
+					"TAXSETTINGS_VAT_COUNTRY_CODE":    config.StringVariable(acctest.SkipIfEnvVarNotSet(t, "TAXSETTINGS_VAT_COUNTRY_CODE")),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTaxRegistrationExists(ctx, t, resourceName, &v),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAccountID:       tfknownvalue.AccountID(),
+						"registration_account_id": knownvalue.NotNull(),
+					}),
+					statecheck.ExpectIdentityValueMatchesStateAtPath(resourceName, tfjsonpath.New("registration_account_id"), tfjsonpath.New(names.AttrAccountID)),
+				},
+			},
+
+			// Step 2: Import command
+			{
+				ConfigDirectory: config.StaticDirectory("testdata/TaxRegistration/basic/"),
+				ConfigVariables: config.Variables{
+					acctest.CtRName:                   config.StringVariable(rName),
+					"TAXSETTINGS_VAT_REGISTRATION_ID": config.StringVariable(acctest.SkipIfEnvVarNotSet(t, "TAXSETTINGS_VAT_REGISTRATION_ID")),
+					"TAXSETTINGS_VAT_COUNTRY_CODE":    config.StringVariable(acctest.SkipIfEnvVarNotSet(t, "TAXSETTINGS_VAT_COUNTRY_CODE")),
+				},
+				ImportStateKind:                      resource.ImportCommandWithID,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrAccountID),
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrAccountID,
+			},
+
+			// Step 3: Import block with Import ID
+			{
+				ConfigDirectory: config.StaticDirectory("testdata/TaxRegistration/basic/"),
+				ConfigVariables: config.Variables{
+					acctest.CtRName:                   config.StringVariable(rName),
+					"TAXSETTINGS_VAT_REGISTRATION_ID": config.StringVariable(acctest.SkipIfEnvVarNotSet(t, "TAXSETTINGS_VAT_REGISTRATION_ID")),
+					"TAXSETTINGS_VAT_COUNTRY_CODE":    config.StringVariable(acctest.SkipIfEnvVarNotSet(t, "TAXSETTINGS_VAT_COUNTRY_CODE")),
+				},
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateKind:   resource.ImportBlockWithID,
+				ImportStateIdFunc: acctest.AttrImportStateIdFunc(resourceName, names.AttrAccountID),
+				ImportPlanChecks: resource.ImportPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrAccountID), knownvalue.NotNull()),
+					},
+				},
+			},
+
+			// Step 4: Import block with Resource Identity
+			{
+				ConfigDirectory: config.StaticDirectory("testdata/TaxRegistration/basic/"),
+				ConfigVariables: config.Variables{
+					acctest.CtRName:                   config.StringVariable(rName),
+					"TAXSETTINGS_VAT_REGISTRATION_ID": config.StringVariable(acctest.SkipIfEnvVarNotSet(t, "TAXSETTINGS_VAT_REGISTRATION_ID")),
+					"TAXSETTINGS_VAT_COUNTRY_CODE":    config.StringVariable(acctest.SkipIfEnvVarNotSet(t, "TAXSETTINGS_VAT_COUNTRY_CODE")),
+				},
+				ResourceName:    resourceName,
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+				ImportPlanChecks: resource.ImportPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrAccountID), knownvalue.NotNull()),
+					},
+				},
+			},
+		},
+	})
+}
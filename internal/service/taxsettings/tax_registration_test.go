@@ -0,0 +1,190 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package taxsettings_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/taxsettings"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/taxsettings/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfknownvalue "github.com/hashicorp/terraform-provider-aws/internal/acctest/knownvalue"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tftaxsettings "github.com/hashicorp/terraform-provider-aws/internal/service/taxsettings"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Tax registrations are validated against the issuing authority and apply to the whole account,
+// so these tests only run serially and when a valid VAT registration is provided.
+const (
+	envVarRegistrationID = "TAXSETTINGS_VAT_REGISTRATION_ID"
+	envVarCountryCode    = "TAXSETTINGS_VAT_COUNTRY_CODE"
+)
+
+func TestAccTaxSettingsTaxRegistration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	registrationID := acctest.SkipIfEnvVarNotSet(t, envVarRegistrationID)
+	countryCode := acctest.SkipIfEnvVarNotSet(t, envVarCountryCode)
+	var v awstypes.TaxRegistration
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_taxsettings_tax_registration.test"
+
+	acctest.Test(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.TaxSettingsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaxRegistrationDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaxRegistrationConfig_basic(rName, registrationID, countryCode),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTaxRegistrationExists(ctx, t, resourceName, &v),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrAccountID), tfknownvalue.AccountID()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("legal_name"), knownvalue.StringExact(rName)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("registration_id"), knownvalue.StringExact(registrationID)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("registration_type"), tfknownvalue.StringExact(awstypes.TaxRegistrationTypeVat)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrStatus), knownvalue.NotNull()),
+				},
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrAccountID),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrAccountID,
+			},
+		},
+	})
+}
+
+func TestAccTaxSettingsTaxRegistration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	registrationID := acctest.SkipIfEnvVarNotSet(t, envVarRegistrationID)
+	countryCode := acctest.SkipIfEnvVarNotSet(t, envVarCountryCode)
+	var v awstypes.TaxRegistration
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_taxsettings_tax_registration.test"
+
+	acctest.Test(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.TaxSettingsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaxRegistrationDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaxRegistrationConfig_basic(rName, registrationID, countryCode),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTaxRegistrationExists(ctx, t, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, t, tftaxsettings.ResourceTaxRegistration, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckTaxRegistrationDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).TaxSettingsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_taxsettings_tax_registration" {
+				continue
+			}
+
+			_, err := tftaxsettings.FindTaxRegistrationByAccountID(ctx, conn, rs.Primary.Attributes[names.AttrAccountID])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Tax Settings Tax Registration %s still exists", rs.Primary.Attributes[names.AttrAccountID])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTaxRegistrationExists(ctx context.Context, t *testing.T, n string, v *awstypes.TaxRegistration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).TaxSettingsClient(ctx)
+
+		output, err := tftaxsettings.FindTaxRegistrationByAccountID(ctx, conn, rs.Primary.Attributes[names.AttrAccountID])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.ProviderMeta(ctx, t).TaxSettingsClient(ctx)
+
+	var input taxsettings.ListTaxRegistrationsInput
+	_, err := conn.ListTaxRegistrations(ctx, &input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccTaxRegistrationConfig_basic(rName, registrationID, countryCode string) string {
+	return fmt.Sprintf(`
+resource "aws_taxsettings_tax_registration" "test" {
+  legal_name        = %[1]q
+  registration_id   = %[2]q
+  registration_type = "VAT"
+
+  legal_address {
+    address_line_1 = "1 Main Street"
+    city           = "Anytown"
+    country_code   = %[3]q
+    postal_code    = "12345"
+  }
+}
+`, rName, registrationID, countryCode)
+}
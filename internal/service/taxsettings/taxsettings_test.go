@@ -0,0 +1,23 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package taxsettings_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+// Tax registrations apply to the whole account, so only one can be tested at a time.
+func TestAccTaxSettings_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]map[string]func(t *testing.T){
+		"TaxRegistration": {
+			"Identity": testAccTaxSettingsTaxRegistration_identitySerial,
+		},
+	}
+
+	acctest.RunSerialTests2Levels(t, testCases, 0)
+}
@@ -0,0 +1,31 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_taxsettings_tax_registration" "test" {
+  legal_name        = var.rName
+  registration_id   = var.TAXSETTINGS_VAT_REGISTRATION_ID
+  registration_type = "VAT"
+
+  legal_address {
+    address_line_1 = "1 Main Street"
+    city           = "Anytown"
+    country_code   = var.TAXSETTINGS_VAT_COUNTRY_CODE
+    postal_code    = "12345"
+  }
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "TAXSETTINGS_VAT_REGISTRATION_ID" {
+  type     = string
+  nullable = false
+}
+
+variable "TAXSETTINGS_VAT_COUNTRY_CODE" {
+  type     = string
+  nullable = false
+}
@@ -0,0 +1,249 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_wellarchitected_lens_share", name="Lens Share")
+// @IdentityAttribute("lens_alias")
+// @IdentityAttribute("share_id")
+// @ImportIDHandler("lensShareImportID")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/wellarchitected/types;awstypes;awstypes.LensShareSummary")
+// @Testing(importStateIdAttributes="lens_alias;share_id", importStateIdAttributesSep="flex.ResourceIdSeparator")
+// @Testing(hasNoPreExistingResource=true)
+// @Testing(identityTest=false)
+func newLensShareResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &lensShareResource{}, nil
+}
+
+type lensShareResource struct {
+	framework.ResourceWithModel[lensShareResourceModel]
+	framework.WithNoUpdate
+	framework.WithImportByIdentity
+}
+
+func (r *lensShareResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"lens_alias": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"share_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"shared_with": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ShareStatus](),
+				Computed:   true,
+			},
+		},
+	}
+}
+
+func (r *lensShareResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan lensShareResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	lensAlias := fwflex.StringValueFromFramework(ctx, plan.LensAlias)
+	var input wellarchitected.CreateLensShareInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, plan, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateLensShare(ctx, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, lensAlias)
+		return
+	}
+
+	shareID := aws.ToString(output.ShareId)
+	share, err := findLensShareByTwoPartKey(ctx, conn, lensAlias, shareID)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, shareID)
+		return
+	}
+
+	// Set values for unknowns.
+	plan.ShareID = fwflex.StringValueToFramework(ctx, shareID)
+	plan.Status = fwtypes.StringEnumValue(share.Status)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, plan))
+}
+
+func (r *lensShareResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data lensShareResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	lensAlias, shareID := fwflex.StringValueFromFramework(ctx, data.LensAlias), fwflex.StringValueFromFramework(ctx, data.ShareID)
+	output, err := findLensShareByTwoPartKey(ctx, conn, lensAlias, shareID)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, shareID)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *lensShareResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data lensShareResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	lensAlias, shareID := fwflex.StringValueFromFramework(ctx, data.LensAlias), fwflex.StringValueFromFramework(ctx, data.ShareID)
+	input := wellarchitected.DeleteLensShareInput{
+		LensAlias: aws.String(lensAlias),
+		ShareId:   aws.String(shareID),
+	}
+	_, err := conn.DeleteLensShare(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, shareID)
+		return
+	}
+}
+
+var (
+	_ inttypes.ImportIDParser = lensShareImportID{}
+)
+
+type lensShareImportID struct{}
+
+func (lensShareImportID) Parse(id string) (string, map[string]any, error) {
+	lensAlias, shareID, found := strings.Cut(id, intflex.ResourceIdSeparator)
+	if !found {
+		return "", nil, fmt.Errorf("id %q should be in the format <lens-alias>%s<share-id>", id, intflex.ResourceIdSeparator)
+	}
+
+	result := map[string]any{
+		"lens_alias": lensAlias,
+		"share_id":   shareID,
+	}
+
+	return id, result, nil
+}
+
+func findLensShareByTwoPartKey(ctx context.Context, conn *wellarchitected.Client, lensAlias, shareID string) (*awstypes.LensShareSummary, error) {
+	input := wellarchitected.ListLensSharesInput{
+		LensAlias: aws.String(lensAlias),
+	}
+
+	return findLensShare(ctx, conn, &input, func(v *awstypes.LensShareSummary) bool {
+		return aws.ToString(v.ShareId) == shareID
+	})
+}
+
+func findLensShare(ctx context.Context, conn *wellarchitected.Client, input *wellarchitected.ListLensSharesInput, filter tfslices.Predicate[*awstypes.LensShareSummary]) (*awstypes.LensShareSummary, error) {
+	output, err := findLensShares(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return smarterr.Assert(tfresource.AssertSingleValueResult(output))
+}
+
+func findLensShares(ctx context.Context, conn *wellarchitected.Client, input *wellarchitected.ListLensSharesInput, filter tfslices.Predicate[*awstypes.LensShareSummary]) ([]awstypes.LensShareSummary, error) {
+	var output []awstypes.LensShareSummary
+
+	pages := wellarchitected.NewListLensSharesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, smarterr.NewError(&retry.NotFoundError{
+				LastError: err,
+			})
+		}
+
+		if err != nil {
+			return nil, smarterr.NewError(err)
+		}
+
+		for _, v := range page.LensShareSummaries {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+type lensShareResourceModel struct {
+	framework.WithRegionModel
+	LensAlias  types.String                             `tfsdk:"lens_alias"`
+	ShareID    types.String                             `tfsdk:"share_id"`
+	SharedWith types.String                             `tfsdk:"shared_with"`
+	Status     fwtypes.StringEnum[awstypes.ShareStatus] `tfsdk:"status"`
+}
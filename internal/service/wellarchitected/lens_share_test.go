@@ -0,0 +1,160 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfwellarchitected "github.com/hashicorp/terraform-provider-aws/internal/service/wellarchitected"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// A custom lens cannot be created with this provider, so the lens to share is supplied via the environment.
+const envVarLensARN = "WELLARCHITECTED_LENS_ARN"
+
+func TestAccWellArchitectedLensShare_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	lensARN := acctest.SkipIfEnvVarNotSet(t, envVarLensARN)
+	var v awstypes.LensShareSummary
+	resourceName := "aws_wellarchitected_lens_share.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckLensShareDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLensShareConfig_basic(lensARN),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLensShareExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "shared_with", "data.aws_caller_identity.alternate", names.AttrAccountID),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("lens_alias"), knownvalue.StringExact(lensARN)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("share_id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrStatus), knownvalue.NotNull()),
+				},
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateIdFunc:                    acctest.AttrsImportStateIdFunc(resourceName, ",", "lens_alias", "share_id"),
+				ImportStateVerifyIdentifierAttribute: "share_id",
+			},
+		},
+	})
+}
+
+func TestAccWellArchitectedLensShare_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	lensARN := acctest.SkipIfEnvVarNotSet(t, envVarLensARN)
+	var v awstypes.LensShareSummary
+	resourceName := "aws_wellarchitected_lens_share.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckLensShareDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLensShareConfig_basic(lensARN),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLensShareExists(ctx, t, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, t, tfwellarchitected.ResourceLensShare, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckLensShareDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).WellArchitectedClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_wellarchitected_lens_share" {
+				continue
+			}
+
+			_, err := tfwellarchitected.FindLensShareByTwoPartKey(ctx, conn, rs.Primary.Attributes["lens_alias"], rs.Primary.Attributes["share_id"])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Well-Architected Lens Share %s still exists", rs.Primary.Attributes["share_id"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckLensShareExists(ctx context.Context, t *testing.T, n string, v *awstypes.LensShareSummary) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).WellArchitectedClient(ctx)
+
+		output, err := tfwellarchitected.FindLensShareByTwoPartKey(ctx, conn, rs.Primary.Attributes["lens_alias"], rs.Primary.Attributes["share_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccLensShareConfig_basic(lensARN string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+data "aws_caller_identity" "alternate" {
+  provider = "awsalternate"
+}
+
+resource "aws_wellarchitected_lens_share" "test" {
+  lens_alias  = %[1]q
+  shared_with = data.aws_caller_identity.alternate.account_id
+}
+`, lensARN))
+}
@@ -0,0 +1,304 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+	"slices"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_wellarchitected_profile", name="Profile")
+// @Tags(identifierAttribute="arn")
+// @ArnIdentity
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/wellarchitected/types;awstypes;awstypes.Profile")
+// @Testing(hasNoPreExistingResource=true)
+func newProfileResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &profileResource{}, nil
+}
+
+type profileResource struct {
+	framework.ResourceWithModel[profileResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *profileResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 100),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 100),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrVersion: schema.StringAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"profile_question": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[profileQuestionModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"question_id": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 128),
+							},
+						},
+						"selected_choice_ids": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Required:    true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *profileResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan profileResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, plan.Name)
+	var input wellarchitected.CreateProfileInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, plan, &input, fwflex.WithFieldNamePrefix("Profile")))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	if input.ProfileQuestions == nil {
+		input.ProfileQuestions = []awstypes.ProfileQuestionUpdate{}
+	}
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateProfile(ctx, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	// Set values for unknowns.
+	plan.ARN = fwflex.StringToFramework(ctx, output.ProfileArn)
+	plan.Version = fwflex.StringToFramework(ctx, output.ProfileVersion)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, plan))
+}
+
+func (r *profileResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data profileResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	arn := fwflex.StringValueFromFramework(ctx, data.ARN)
+	output, err := findProfileByARN(ctx, conn, arn)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, flattenProfile(ctx, output, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *profileResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old profileResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &new))
+	if response.Diagnostics.HasError() {
+		return
+	}
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &old))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	diff, d := fwflex.Diff(ctx, new, old, fwflex.WithIgnoredField("Version"))
+	smerr.AddEnrich(ctx, &response.Diagnostics, d)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		arn := fwflex.StringValueFromFramework(ctx, new.ARN)
+		var input wellarchitected.UpdateProfileInput
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, new, &input, fwflex.WithFieldNamePrefix("Profile")))
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Clear the selections of questions that are no longer configured.
+		newQuestions, d := new.ProfileQuestions.ToSlice(ctx)
+		smerr.AddEnrich(ctx, &response.Diagnostics, d)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		oldQuestions, d := old.ProfileQuestions.ToSlice(ctx)
+		smerr.AddEnrich(ctx, &response.Diagnostics, d)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		for _, v := range oldQuestions {
+			if !slices.ContainsFunc(newQuestions, func(e *profileQuestionModel) bool {
+				return e.QuestionID.Equal(v.QuestionID)
+			}) {
+				input.ProfileQuestions = append(input.ProfileQuestions, awstypes.ProfileQuestionUpdate{
+					QuestionId:        fwflex.StringFromFramework(ctx, v.QuestionID),
+					SelectedChoiceIds: []string{},
+				})
+			}
+		}
+
+		output, err := conn.UpdateProfile(ctx, &input)
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+
+		new.Version = fwflex.StringToFramework(ctx, output.Profile.ProfileVersion)
+	} else {
+		new.Version = old.Version
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &new))
+}
+
+func (r *profileResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data profileResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	arn := fwflex.StringValueFromFramework(ctx, data.ARN)
+	input := wellarchitected.DeleteProfileInput{
+		ProfileArn: aws.String(arn),
+	}
+	_, err := conn.DeleteProfile(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+		return
+	}
+}
+
+// flattenProfile flattens a profile, keeping only the questions with selected choices.
+func flattenProfile(ctx context.Context, apiObject *awstypes.Profile, data *profileResourceModel) diag.Diagnostics {
+	profile := *apiObject
+	profile.ProfileQuestions = tfslices.Filter(profile.ProfileQuestions, func(v awstypes.ProfileQuestion) bool {
+		return len(v.SelectedChoiceIds) > 0
+	})
+
+	return fwflex.Flatten(ctx, &profile, data, fwflex.WithFieldNamePrefix("Profile"))
+}
+
+func findProfileByARN(ctx context.Context, conn *wellarchitected.Client, arn string) (*awstypes.Profile, error) {
+	input := wellarchitected.GetProfileInput{
+		ProfileArn: aws.String(arn),
+	}
+	output, err := conn.GetProfile(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil || output.Profile == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError())
+	}
+
+	return output.Profile, nil
+}
+
+type profileResourceModel struct {
+	framework.WithRegionModel
+	ARN              types.String                                         `tfsdk:"arn"`
+	Description      types.String                                         `tfsdk:"description"`
+	Name             types.String                                         `tfsdk:"name"`
+	ProfileQuestions fwtypes.SetNestedObjectValueOf[profileQuestionModel] `tfsdk:"profile_question"`
+	Tags             tftags.Map                                           `tfsdk:"tags"`
+	TagsAll          tftags.Map                                           `tfsdk:"tags_all"`
+	Version          types.String                                         `tfsdk:"version"`
+}
+
+type profileQuestionModel struct {
+	QuestionID        types.String        `tfsdk:"question_id"`
+	SelectedChoiceIDs fwtypes.SetOfString `tfsdk:"selected_choice_ids"`
+}
@@ -0,0 +1,14 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_wellarchitected_review_template" "test" {
+  name        = var.rName
+  description = "Terraform acceptance test"
+  lenses      = ["wellarchitected"]
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
@@ -0,0 +1,18 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_wellarchitected_workload" "test" {
+  workload_name = var.rName
+  description   = "Terraform acceptance test"
+  environment   = "PREPRODUCTION"
+  lenses        = ["wellarchitected"]
+  aws_regions   = [data.aws_region.current.region]
+}
+
+data "aws_region" "current" {}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
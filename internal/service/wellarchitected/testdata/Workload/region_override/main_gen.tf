@@ -0,0 +1,28 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_wellarchitected_workload" "test" {
+  region = var.region
+
+  workload_name = var.rName
+  description   = "Terraform acceptance test"
+  environment   = "PREPRODUCTION"
+  lenses        = ["wellarchitected"]
+  aws_regions   = [data.aws_region.current.region]
+}
+
+data "aws_region" "current" {
+  region = var.region
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "region" {
+  description = "Region to deploy resource in"
+  type        = string
+  nullable    = false
+}
@@ -0,0 +1,27 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_wellarchitected_workload" "test" {
+  workload_name = var.rName
+  description   = "Terraform acceptance test"
+  environment   = "PREPRODUCTION"
+  lenses        = ["wellarchitected"]
+  aws_regions   = [data.aws_region.current.region]
+
+  tags = var.resource_tags
+}
+
+data "aws_region" "current" {}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "resource_tags" {
+  description = "Tags to set on resource. To specify no tags, set to `null`"
+  # Not setting a default, so that this must explicitly be set to `null` to specify no tags
+  type     = map(string)
+  nullable = true
+}
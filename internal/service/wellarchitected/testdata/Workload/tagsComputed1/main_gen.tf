@@ -0,0 +1,31 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+provider "null" {}
+
+resource "aws_wellarchitected_workload" "test" {
+  workload_name = var.rName
+  description   = "Terraform acceptance test"
+  environment   = "PREPRODUCTION"
+  lenses        = ["wellarchitected"]
+  aws_regions   = [data.aws_region.current.region]
+
+  tags = {
+    (var.unknownTagKey) = null_resource.test.id
+  }
+}
+
+data "aws_region" "current" {}
+
+resource "null_resource" "test" {}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "unknownTagKey" {
+  type     = string
+  nullable = false
+}
@@ -0,0 +1,483 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_wellarchitected_workload", name="Workload")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/wellarchitected/types;awstypes;awstypes.Workload")
+// @Testing(hasNoPreExistingResource=true)
+func newWorkloadResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &workloadResource{}, nil
+}
+
+type workloadResource struct {
+	framework.ResourceWithModel[workloadResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *workloadResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"account_ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(100),
+					setvalidator.ValueStringsAre(fwvalidators.AWSAccountID()),
+				},
+			},
+			"applications": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(1),
+				},
+			},
+			"architectural_design": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(2048),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"aws_regions": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(50),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 250),
+				},
+			},
+			names.AttrEnvironment: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.WorkloadEnvironment](),
+				Required:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"industry": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(100),
+				},
+			},
+			"industry_type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(100),
+				},
+			},
+			"lenses": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"non_aws_regions": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(5),
+				},
+			},
+			"notes": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(2084),
+				},
+			},
+			names.AttrOwner: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"pillar_priorities": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+				},
+			},
+			"profile_arns": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(1),
+				},
+			},
+			"review_owner": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 255),
+				},
+			},
+			"review_template_arns": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"workload_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 100),
+				},
+			},
+		},
+	}
+}
+
+func (r *workloadResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan workloadResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, plan.WorkloadName)
+	var input wellarchitected.CreateWorkloadInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, plan, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ProfileArns = fwflex.ExpandFrameworkStringValueSet(ctx, plan.ProfileARNs)
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateWorkload(ctx, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	id := aws.ToString(output.WorkloadId)
+	workload, err := findWorkloadByID(ctx, conn, id)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	// Set values for unknowns.
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, workload, &plan, fwflex.WithFieldNamePrefix("Workload")))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, plan))
+}
+
+func (r *workloadResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data workloadResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.ID)
+	output, err := findWorkloadByID(ctx, conn, id)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &data, fwflex.WithFieldNamePrefix("Workload")))
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.ProfileARNs = fwflex.FlattenFrameworkStringValueSetOfString(ctx, tfslices.ApplyToAll(output.Profiles, func(v awstypes.WorkloadProfile) string {
+		return aws.ToString(v.ProfileArn)
+	}))
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *workloadResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old workloadResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &new))
+	if response.Diagnostics.HasError() {
+		return
+	}
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &old))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, new.ID)
+
+	diff, d := fwflex.Diff(ctx, new, old, fwflex.WithIgnoredField("Lenses"), fwflex.WithIgnoredField("ProfileARNs"))
+	smerr.AddEnrich(ctx, &response.Diagnostics, d)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		var input wellarchitected.UpdateWorkloadInput
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, new, &input))
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.WorkloadId = aws.String(id)
+		// Explicitly clear removed values.
+		if new.AccountIDs.IsNull() {
+			input.AccountIds = []string{}
+		}
+		if new.Applications.IsNull() {
+			input.Applications = []string{}
+		}
+		if new.ArchitecturalDesign.IsNull() {
+			input.ArchitecturalDesign = aws.String("")
+		}
+		if new.AWSRegions.IsNull() {
+			input.AwsRegions = []string{}
+		}
+		if new.NonAWSRegions.IsNull() {
+			input.NonAwsRegions = []string{}
+		}
+		if new.Notes.IsNull() {
+			input.Notes = aws.String("")
+		}
+
+		_, err := conn.UpdateWorkload(ctx, &input)
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+			return
+		}
+	}
+
+	if !new.Lenses.Equal(old.Lenses) {
+		oldLenses, newLenses := fwflex.ExpandFrameworkStringValueSet(ctx, old.Lenses), fwflex.ExpandFrameworkStringValueSet(ctx, new.Lenses)
+		add, remove := newLenses.Difference(oldLenses), oldLenses.Difference(newLenses)
+
+		if len(add) > 0 {
+			input := wellarchitected.AssociateLensesInput{
+				LensAliases: add,
+				WorkloadId:  aws.String(id),
+			}
+
+			_, err := conn.AssociateLenses(ctx, &input)
+
+			if err != nil {
+				smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+				return
+			}
+		}
+
+		if len(remove) > 0 {
+			input := wellarchitected.DisassociateLensesInput{
+				LensAliases: remove,
+				WorkloadId:  aws.String(id),
+			}
+
+			_, err := conn.DisassociateLenses(ctx, &input)
+
+			if err != nil {
+				smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+				return
+			}
+		}
+	}
+
+	if !new.ProfileARNs.Equal(old.ProfileARNs) {
+		oldARNs, newARNs := fwflex.ExpandFrameworkStringValueSet(ctx, old.ProfileARNs), fwflex.ExpandFrameworkStringValueSet(ctx, new.ProfileARNs)
+		add, remove := newARNs.Difference(oldARNs), oldARNs.Difference(newARNs)
+
+		if len(remove) > 0 {
+			input := wellarchitected.DisassociateProfilesInput{
+				ProfileArns: remove,
+				WorkloadId:  aws.String(id),
+			}
+
+			_, err := conn.DisassociateProfiles(ctx, &input)
+
+			if err != nil {
+				smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+				return
+			}
+		}
+
+		if len(add) > 0 {
+			input := wellarchitected.AssociateProfilesInput{
+				ProfileArns: add,
+				WorkloadId:  aws.String(id),
+			}
+
+			_, err := conn.AssociateProfiles(ctx, &input)
+
+			if err != nil {
+				smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+				return
+			}
+		}
+	}
+
+	if new.PillarPriorities.IsUnknown() {
+		output, err := findWorkloadByID(ctx, conn, id)
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+			return
+		}
+
+		new.PillarPriorities = fwflex.FlattenFrameworkStringValueListOfString(ctx, output.PillarPriorities)
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &new))
+}
+
+func (r *workloadResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data workloadResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.ID)
+	input := wellarchitected.DeleteWorkloadInput{
+		WorkloadId: aws.String(id),
+	}
+	_, err := conn.DeleteWorkload(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+}
+
+func findWorkloadByID(ctx context.Context, conn *wellarchitected.Client, id string) (*awstypes.Workload, error) {
+	input := wellarchitected.GetWorkloadInput{
+		WorkloadId: aws.String(id),
+	}
+	output, err := conn.GetWorkload(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil || output.Workload == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError())
+	}
+
+	return output.Workload, nil
+}
+
+type workloadResourceModel struct {
+	framework.WithRegionModel
+	AccountIDs          fwtypes.SetOfString                              `tfsdk:"account_ids"`
+	Applications        fwtypes.SetOfString                              `tfsdk:"applications"`
+	ArchitecturalDesign types.String                                     `tfsdk:"architectural_design" autoflex:",omitempty"`
+	ARN                 types.String                                     `tfsdk:"arn"`
+	AWSRegions          fwtypes.SetOfString                              `tfsdk:"aws_regions"`
+	Description         types.String                                     `tfsdk:"description"`
+	Environment         fwtypes.StringEnum[awstypes.WorkloadEnvironment] `tfsdk:"environment"`
+	ID                  types.String                                     `tfsdk:"id"`
+	Industry            types.String                                     `tfsdk:"industry"`
+	IndustryType        types.String                                     `tfsdk:"industry_type"`
+	Lenses              fwtypes.SetOfString                              `tfsdk:"lenses"`
+	NonAWSRegions       fwtypes.SetOfString                              `tfsdk:"non_aws_regions"`
+	Notes               types.String                                     `tfsdk:"notes" autoflex:",omitempty"`
+	Owner               types.String                                     `tfsdk:"owner"`
+	PillarPriorities    fwtypes.ListOfString                             `tfsdk:"pillar_priorities"`
+	ProfileARNs         fwtypes.SetOfString                              `tfsdk:"profile_arns" autoflex:"-"`
+	ReviewOwner         types.String                                     `tfsdk:"review_owner"`
+	ReviewTemplateARNs  fwtypes.SetOfString                              `tfsdk:"review_template_arns"`
+	Tags                tftags.Map                                       `tfsdk:"tags"`
+	TagsAll             tftags.Map                                       `tfsdk:"tags_all"`
+	WorkloadName        types.String                                     `tfsdk:"workload_name"`
+}
@@ -0,0 +1,340 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfknownvalue "github.com/hashicorp/terraform-provider-aws/internal/acctest/knownvalue"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfwellarchitected "github.com/hashicorp/terraform-provider-aws/internal/service/wellarchitected"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWellArchitectedWorkload_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Workload
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_wellarchitected_workload.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkloadDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkloadConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkloadExists(ctx, t, resourceName, &v),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrARN), tfknownvalue.RegionalARNRegexp("wellarchitected", regexache.MustCompile(`workload/.+$`))),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("aws_regions"), knownvalue.SetSizeExact(1)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrDescription), knownvalue.StringExact("Terraform acceptance test")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrEnvironment), tfknownvalue.StringExact(awstypes.WorkloadEnvironmentPreproduction)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrID), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("lenses"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact("wellarchitected"),
+					})),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrOwner), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("pillar_priorities"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.Null()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("workload_name"), knownvalue.StringExact(rName)),
+				},
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccWellArchitectedWorkload_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Workload
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_wellarchitected_workload.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkloadDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkloadConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkloadExists(ctx, t, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, t, tfwellarchitected.ResourceWorkload, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccWellArchitectedWorkload_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Workload
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_wellarchitected_workload.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkloadDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkloadConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkloadExists(ctx, t, resourceName, &v),
+				),
+			},
+			{
+				Config: testAccWorkloadConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkloadExists(ctx, t, resourceName, &v),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("account_ids"), knownvalue.SetSizeExact(1)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("architectural_design"), knownvalue.StringExact("https://example.com/design")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrDescription), knownvalue.StringExact("Terraform acceptance test updated")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrEnvironment), tfknownvalue.StringExact(awstypes.WorkloadEnvironmentProduction)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("lenses"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact("serverless"),
+						knownvalue.StringExact("wellarchitected"),
+					})),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("notes"), knownvalue.StringExact("Managed by Terraform")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("pillar_priorities"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringExact("security"),
+						knownvalue.StringExact("reliability"),
+						knownvalue.StringExact("operationalExcellence"),
+						knownvalue.StringExact("performance"),
+						knownvalue.StringExact("costOptimization"),
+						knownvalue.StringExact("sustainability"),
+					})),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("review_owner"), knownvalue.StringExact("owner@example.com")),
+				},
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccWorkloadConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkloadExists(ctx, t, resourceName, &v),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("account_ids"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("architectural_design"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("lenses"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact("wellarchitected"),
+					})),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("notes"), knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccWellArchitectedWorkload_profileARNs(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Workload
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_wellarchitected_workload.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkloadDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkloadConfig_profileARNs(rName, 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkloadExists(ctx, t, resourceName, &v),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("profile_arns"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.NotNull(),
+					})),
+				},
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccWorkloadConfig_profileARNs(rName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkloadExists(ctx, t, resourceName, &v),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "profile_arns.*", "aws_wellarchitected_profile.test.1", names.AttrARN),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckWorkloadDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).WellArchitectedClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_wellarchitected_workload" {
+				continue
+			}
+
+			_, err := tfwellarchitected.FindWorkloadByID(ctx, conn, rs.Primary.Attributes[names.AttrID])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Well-Architected Workload %s still exists", rs.Primary.Attributes[names.AttrID])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckWorkloadExists(ctx context.Context, t *testing.T, n string, v *awstypes.Workload) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).WellArchitectedClient(ctx)
+
+		output, err := tfwellarchitected.FindWorkloadByID(ctx, conn, rs.Primary.Attributes[names.AttrID])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccWorkloadConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_wellarchitected_workload" "test" {
+  workload_name = %[1]q
+  description   = "Terraform acceptance test"
+  environment   = "PREPRODUCTION"
+  lenses        = ["wellarchitected"]
+  aws_regions   = [data.aws_region.current.region]
+}
+`, rName)
+}
+
+func testAccWorkloadConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_wellarchitected_workload" "test" {
+  workload_name        = %[1]q
+  description          = "Terraform acceptance test updated"
+  environment          = "PRODUCTION"
+  lenses               = ["serverless", "wellarchitected"]
+  account_ids          = [data.aws_caller_identity.current.account_id]
+  aws_regions          = [data.aws_region.current.region]
+  architectural_design = "https://example.com/design"
+  notes                = "Managed by Terraform"
+  review_owner         = "owner@example.com"
+
+  pillar_priorities = [
+    "security",
+    "reliability",
+    "operationalExcellence",
+    "performance",
+    "costOptimization",
+    "sustainability",
+  ]
+}
+`, rName)
+}
+
+func testAccWorkloadConfig_profileARNs(rName string, idx int) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_wellarchitected_profile" "test" {
+  count = 2
+
+  name        = "%[1]s-${count.index}"
+  description = "Terraform acceptance test"
+}
+
+resource "aws_wellarchitected_workload" "test" {
+  workload_name = %[1]q
+  description   = "Terraform acceptance test"
+  environment   = "PREPRODUCTION"
+  lenses        = ["wellarchitected"]
+  aws_regions   = [data.aws_region.current.region]
+  profile_arns  = [aws_wellarchitected_profile.test[%[2]d].arn]
+}
+`, rName, idx)
+}